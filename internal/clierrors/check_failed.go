@@ -0,0 +1,20 @@
+package clierrors
+
+var _ ClientError = CheckFailedError{}
+
+// CheckFailedError is returned by commands that check the state of a resource, e.g., compare metrics to thresholds, when the check does not pass. The output of the command is still rendered.
+type CheckFailedError struct {
+	Err error
+}
+
+func (err CheckFailedError) ErrorCode() int {
+	return CheckFailed
+}
+
+func (err CheckFailedError) Error() string {
+	return err.Err.Error()
+}
+
+func (err CheckFailedError) Unwrap() error {
+	return err.Err
+}
@@ -5,6 +5,7 @@ const (
 	InterruptSignalCode  int = 101
 	MissingCredentials   int = 102
 	InvalidCredentials   int = 103
+	CheckFailed          int = 104
 )
 
 // ClientError declares interface for errors known to the client that set specific error code.
@@ -18,6 +18,7 @@ func LoginCommand() commands.Command {
 			"login",
 			"Configure an authentication token to the system keyring (EXPERIMENTAL) ",
 			"upctl account login --with-token",
+			"upctl account login --with-token --profile staging",
 		),
 	}
 }
@@ -26,6 +27,7 @@ type loginCommand struct {
 	*commands.BaseCommand
 
 	withToken config.OptionalBoolean
+	cfg       *config.Config
 }
 
 // InitCommand implements Command.InitCommand
@@ -38,6 +40,11 @@ func (s *loginCommand) InitCommand() {
 	commands.Must(s.Cobra().MarkFlagRequired("with-token"))
 }
 
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (s *loginCommand) InitCommandWithConfig(cfg *config.Config) {
+	s.cfg = cfg
+}
+
 // DoesNotUseServices implements commands.OfflineCommand as this command does not use services
 func (s *loginCommand) DoesNotUseServices() {}
 
@@ -57,9 +64,13 @@ func (s *loginCommand) executeWithToken(exec commands.Executor) (output.Output,
 		return nil, fmt.Errorf("failed to read token from standard input: %w", err)
 	}
 
+	profile := s.cfg.Profile()
 	msg := "Saving provided token to the system keyring."
+	if profile != "" {
+		msg = fmt.Sprintf("Saving provided token for profile %s to the system keyring.", profile)
+	}
 	exec.PushProgressStarted(msg)
-	err = config.SaveTokenToKeyring(strings.TrimSpace(token))
+	err = config.SaveTokenToKeyring(profile, strings.TrimSpace(token))
 	if err != nil {
 		return commands.HandleError(exec, msg, err)
 	}
@@ -0,0 +1,65 @@
+package profile
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+)
+
+// ListCommand creates the "account profile list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List configuration profiles",
+			"upctl account profile list",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	cfg *config.Config
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (s *listCommand) InitCommandWithConfig(cfg *config.Config) {
+	s.cfg = cfg
+}
+
+// DoesNotUseServices implements commands.OfflineCommand as this command does not use services
+func (s *listCommand) DoesNotUseServices() {}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *listCommand) ExecuteWithoutArguments(_ commands.Executor) (output.Output, error) {
+	profiles := make([]profileSummary, 0)
+	rows := []output.TableRow{}
+	for _, name := range s.cfg.Profiles() {
+		settings, _ := s.cfg.ProfileSettings(name)
+		profile := summarize(s.cfg, name, settings)
+		profiles = append(profiles, profile)
+		rows = append(rows, output.TableRow{
+			profile.Name,
+			profile.Active,
+			profile.Credentials,
+			profile.Zone,
+			profile.Output,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: profiles,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "active", Header: "Active", Format: format.Boolean},
+				{Key: "credentials", Header: "Credentials"},
+				{Key: "zone", Header: "Zone"},
+				{Key: "output", Header: "Output"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No profiles defined in the configuration file.",
+		},
+	}, nil
+}
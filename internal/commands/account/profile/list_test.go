@@ -0,0 +1,85 @@
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `profile: staging
+profiles:
+  staging:
+    token: ucat_staging
+    zone: de-fra1
+  production:
+    username: production_user
+    output: json
+`
+
+func loadTestConfig(t *testing.T) *config.Config {
+	t.Helper()
+	t.Setenv("UPCLOUD_PROFILE", "")
+
+	path := filepath.Join(t.TempDir(), "upctl.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfig), 0o600))
+
+	conf := config.New()
+	conf.GlobalFlags.ConfigFile = path
+	require.NoError(t, conf.Load())
+	return conf
+}
+
+func TestListCommand(t *testing.T) {
+	text.DisableColors()
+	conf := loadTestConfig(t)
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputHuman)
+
+	expected := `
+ Name         Active   Credentials                     Zone      Output 
+──────────── ──────── ─────────────────────────────── ───────── ────────
+ production   no       username, password in keyring             json   
+ staging      yes      token                           de-fra1          
+
+`
+
+	mService := smock.Service{}
+	c := commands.BuildCommand(ListCommand(), nil, conf)
+	output, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, output)
+}
+
+func TestShowCommand_NotDefined(t *testing.T) {
+	conf := loadTestConfig(t)
+
+	mService := smock.Service{}
+	c := commands.BuildCommand(ShowCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"development"})
+	_, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.EqualError(t, err, "profile 'development' is not defined in the configuration file")
+}
+
+func TestUseCommand(t *testing.T) {
+	conf := loadTestConfig(t)
+
+	mService := smock.Service{}
+	c := commands.BuildCommand(UseCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"production"})
+	_, err := mockexecute.MockExecute(c, &mService, conf)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(conf.GetString("config"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "profile: production\n")
+}
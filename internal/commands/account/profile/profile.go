@@ -0,0 +1,86 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// BaseProfileCommand creates the base 'profile' command
+func BaseProfileCommand() commands.Command {
+	return &profileCommand{commands.New("profile", "Manage configuration profiles")}
+}
+
+type profileCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (s *profileCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Manage configuration profiles
+
+Profiles are defined in the ` + "`" + `profiles` + "`" + ` section of the configuration file. Each profile can define its own credentials and defaults, e.g., ` + "`" + `zone` + "`" + ` and ` + "`" + `output` + "`" + `. Select the profile to use with ` + "`" + `--profile` + "`" + ` flag, ` + "`" + `UPCLOUD_PROFILE` + "`" + ` environment variable, or ` + "`" + `upctl account profile use` + "`" + ` command. Tokens can be saved to the system keyring for a profile with ` + "`" + `upctl account login --with-token --profile <name>` + "`" + `.`)
+}
+
+type profileSummary struct {
+	Name          string `json:"name"`
+	Active        bool   `json:"active"`
+	Credentials   string `json:"credentials"`
+	Zone          string `json:"zone,omitempty"`
+	Output        string `json:"output,omitempty"`
+	ClientTimeout string `json:"client_timeout,omitempty"`
+}
+
+func summarize(cfg *config.Config, name string, settings map[string]any) profileSummary {
+	return profileSummary{
+		Name:          name,
+		Active:        name == cfg.Profile(),
+		Credentials:   credentialsSource(settings),
+		Zone:          stringSetting(settings, config.KeyZone),
+		Output:        stringSetting(settings, config.KeyOutput),
+		ClientTimeout: stringSetting(settings, config.KeyClientTimeout),
+	}
+}
+
+func credentialsSource(settings map[string]any) string {
+	switch {
+	case stringSetting(settings, "token") != "":
+		return "token"
+	case stringSetting(settings, "username") != "" && stringSetting(settings, "password") != "":
+		return "username and password"
+	case stringSetting(settings, "username") != "":
+		return "username, password in keyring"
+	default:
+		return "token in keyring"
+	}
+}
+
+func stringSetting(settings map[string]any, key string) string {
+	value, ok := settings[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func getProfile(cfg *config.Config, name string) (map[string]any, error) {
+	settings, ok := cfg.ProfileSettings(name)
+	if !ok {
+		return nil, fmt.Errorf("profile '%s' is not defined in the configuration file", name)
+	}
+	return settings, nil
+}
+
+func completeProfiles(cfg *config.Config) cobra.CompletionFunc {
+	return func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if err := cfg.Load(); err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return cfg.Profiles(), cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,60 @@
+package profile
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+)
+
+// ShowCommand creates the "account profile show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show configuration profile details",
+			"upctl account profile show staging",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	cfg *config.Config
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (s *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	s.cfg = cfg
+	s.Cobra().ValidArgsFunction = completeProfiles(cfg)
+}
+
+// DoesNotUseServices implements commands.OfflineCommand as this command does not use services
+func (s *showCommand) DoesNotUseServices() {}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *showCommand) ExecuteSingleArgument(_ commands.Executor, arg string) (output.Output, error) {
+	settings, err := getProfile(s.cfg, arg)
+	if err != nil {
+		return nil, err
+	}
+	profile := summarize(s.cfg, arg, settings)
+
+	return output.MarshaledWithHumanOutput{
+		Value: profile,
+		Output: output.Details{
+			Sections: []output.DetailSection{
+				{
+					Rows: []output.DetailRow{
+						{Title: "Name:", Key: "name", Value: profile.Name},
+						{Title: "Active:", Key: "active", Value: profile.Active, Format: format.Boolean},
+						{Title: "Credentials:", Key: "credentials", Value: profile.Credentials},
+						{Title: "Zone:", Key: "zone", Value: profile.Zone},
+						{Title: "Output:", Key: "output", Value: profile.Output},
+						{Title: "Client timeout:", Key: "client_timeout", Value: profile.ClientTimeout},
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,59 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+)
+
+// UseCommand creates the "account profile use" command
+func UseCommand() commands.Command {
+	return &useCommand{
+		BaseCommand: commands.New(
+			"use",
+			"Set the configuration profile used by default",
+			"upctl account profile use staging",
+		),
+	}
+}
+
+type useCommand struct {
+	*commands.BaseCommand
+	cfg *config.Config
+}
+
+// InitCommand implements Command.InitCommand
+func (s *useCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Set the configuration profile used by default
+
+Stores the profile name in the ` + "`" + `profile` + "`" + ` key of the configuration file. The ` + "`" + `--profile` + "`" + ` flag and ` + "`" + `UPCLOUD_PROFILE` + "`" + ` environment variable take precedence over the value stored in the configuration file.`)
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (s *useCommand) InitCommandWithConfig(cfg *config.Config) {
+	s.cfg = cfg
+	s.Cobra().ValidArgsFunction = completeProfiles(cfg)
+}
+
+// DoesNotUseServices implements commands.OfflineCommand as this command does not use services
+func (s *useCommand) DoesNotUseServices() {}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *useCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	if _, err := getProfile(s.cfg, arg); err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Setting %s as the default profile", arg)
+	exec.PushProgressStarted(msg)
+
+	if err := s.cfg.SetDefaultProfile(arg); err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
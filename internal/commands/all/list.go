@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -19,6 +20,7 @@ func ListCommand() commands.Command {
 			"List all resources within the current account",
 			"upctl all list",
 			"upctl all list --include *tf-acc-test* --exclude *persistent*",
+			"upctl all list --selector env=dev --filter type=server",
 		),
 	}
 }
@@ -27,6 +29,7 @@ type listCommand struct {
 	*commands.BaseCommand
 	include []string
 	exclude []string
+	config  *config.Config
 }
 
 func (c *listCommand) InitCommand() {
@@ -36,12 +39,22 @@ func (c *listCommand) InitCommand() {
 	c.AddFlags(flags)
 }
 
+// InitCommandWithConfig implements commands.Command
+func (c *listCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.config = cfg
+}
+
 // ExecuteWithoutArguments implements commands.NoArgumentCommand
 func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
 	resources, err := ListResources(exec, c.include, c.exclude)
 	if err != nil {
 		return nil, err
 	}
+	if c.config.Selector() != "" || c.config.ColumnShown("labels") {
+		if err := addServerLabels(exec, resources); err != nil {
+			return nil, err
+		}
+	}
 
 	rows := []output.TableRow{}
 	for _, resource := range resources {
@@ -49,6 +62,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			resource.Type,
 			resource.UUID,
 			resource.Name,
+			labelsCell(resource),
 		})
 	}
 
@@ -57,6 +71,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			{Key: "type", Header: "Type"},
 			{Key: "uuid", Header: "UUID", Format: formatUUID},
 			{Key: "name", Header: "Name"},
+			{Key: "labels", Header: "Labels", Hidden: true},
 		},
 		Rows: rows,
 	}, nil
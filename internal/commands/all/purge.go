@@ -1,7 +1,11 @@
 package all
 
 import (
+	"slices"
+
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 
 	"github.com/spf13/pflag"
@@ -15,6 +19,8 @@ func PurgeCommand() commands.Command {
 			"Delete all resources from the current account",
 			"upctl all purge",
 			"upctl all purge --include *tf-acc-test* --exclude *persistent*",
+			"upctl all purge --selector 'env in (dev,test)' --filter type!=storage",
+			"upctl all purge --include *tf-acc-test* --yes",
 		),
 	}
 }
@@ -23,10 +29,11 @@ type purgeCommand struct {
 	*commands.BaseCommand
 	include []string
 	exclude []string
+	config  *config.Config
 }
 
 func (c *purgeCommand) InitCommand() {
-	c.Cobra().Long = `Delete all resources from the current account. Use ` + "`" + `upctl all list` + "`" + ` command to preview targeted resources before purging.`
+	c.Cobra().Long = `Delete all resources from the current account. Use ` + "`" + `upctl all list` + "`" + ` command to preview targeted resources before purging. The global ` + "`" + `--filter` + "`" + ` and ` + "`" + `--selector` + "`" + ` flags limit the targeted resources the same way as they limit the output of ` + "`" + `upctl all list` + "`" + ` command. The targeted resources are listed and the operation must be confirmed before the resources are deleted. Use ` + "`" + `--yes` + "`" + ` flag to skip the confirmation.`
 
 	flags := &pflag.FlagSet{}
 	flags.StringArrayVarP(&c.include, "include", "i", []string{"*"}, includeHelp)
@@ -34,6 +41,11 @@ func (c *purgeCommand) InitCommand() {
 	c.AddFlags(flags)
 }
 
+// InitCommandWithConfig implements commands.Command
+func (c *purgeCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.config = cfg
+}
+
 // ExecuteWithoutArguments implements commands.NoArgumentCommand
 func (c *purgeCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
 	resources, err := ListResources(exec, c.include, c.exclude)
@@ -41,6 +53,32 @@ func (c *purgeCommand) ExecuteWithoutArguments(exec commands.Executor) (output.O
 		return nil, err
 	}
 
+	filters, err := filter.ParseAll(c.config.Filters())
+	if err != nil {
+		return nil, err
+	}
+	selector, err := filter.ParseSelector(c.config.Selector())
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) > 0 || slices.ContainsFunc(filters, func(f filter.Filter) bool { return f.Key == "labels" }) {
+		if err := addServerLabels(exec, resources); err != nil {
+			return nil, err
+		}
+	}
+	resources, err = filterResources(resources, filters, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmResources := make([]commands.ConfirmResource, 0, len(resources))
+	for _, resource := range resources {
+		confirmResources = append(confirmResources, commands.ConfirmResource{Type: resource.Type, UUID: resource.UUID, Name: resource.Name})
+	}
+	if err := commands.ConfirmDeletion(c, c.config, confirmResources); err != nil {
+		return nil, err
+	}
+
 	err = DeleteResources(exec, resources, 16)
 	if err != nil {
 		return nil, err
@@ -9,15 +9,18 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestPurgeCommand(t *testing.T) {
 	for _, test := range []struct {
-		name   string
-		args   []string
-		called [][]any
+		name    string
+		args    []string
+		filters []string
+		called  [][]any
 	}{
 		{
 			name: "purge non-persistent tf-acc-test resources",
@@ -31,6 +34,16 @@ func TestPurgeCommand(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name:    "purge tf-acc-test networks",
+			args:    []string{"--include", "*tf-acc-test*"},
+			filters: []string{"type=network"},
+			called: [][]any{
+				{"DeleteNetwork", &request.DeleteNetworkRequest{
+					UUID: networks.Networks[0].UUID,
+				}},
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			text.DisableColors()
@@ -44,6 +57,8 @@ func TestPurgeCommand(t *testing.T) {
 			}
 
 			conf := config.New()
+			conf.Viper().Set(config.KeyFilter, test.filters)
+			conf.Viper().Set(config.KeyYes, true)
 			command := commands.BuildCommand(PurgeCommand(), nil, conf)
 
 			command.Cobra().SetArgs(test.args)
@@ -59,3 +74,56 @@ func TestPurgeCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestPurgeCommand_NotConfirmed(t *testing.T) {
+	mService := smock.Service{}
+	mockListResponses(&mService)
+
+	conf := config.New()
+	command := commands.BuildCommand(PurgeCommand(), nil, conf)
+
+	command.Cobra().SetArgs([]string{"--include", "*tf-acc-test*"})
+	_, err := mockexecute.MockExecute(command, &mService, conf)
+
+	assert.EqualError(t, err, "confirming the operation requires an interactive terminal. Use --yes flag to confirm the operation in non-interactive mode")
+	mService.AssertNotCalled(t, "DeleteNetwork")
+	mService.AssertNotCalled(t, "DeleteManagedObjectStorage")
+}
+
+func TestPurgeCommand_SelectorWithServerLabels(t *testing.T) {
+	keep := upcloud.ServerDetails{
+		Server: upcloud.Server{UUID: "00333d1b-3a4a-4b75-820a-4a56d70395dd", Title: "keep-server", State: upcloud.ServerStateStopped},
+		Labels: upcloud.LabelSlice{{Key: "keep", Value: "true"}},
+	}
+	tmp := upcloud.ServerDetails{
+		Server: upcloud.Server{UUID: "0077fa3d-32db-4b09-9f5f-30d9e9afb565", Title: "tmp-server", State: upcloud.ServerStateStopped},
+	}
+
+	mService := smock.Service{}
+	mService.On("GetManagedDatabases", mock.Anything).Return(nil, nil)
+	mService.On("GetManagedObjectStorages", mock.Anything).Return(nil, nil)
+	mService.On("GetNetworks").Return(&upcloud.Networks{}, nil)
+	mService.On("GetRouters").Return(&upcloud.Routers{}, nil)
+	mService.On("GetServers").Return(&upcloud.Servers{Servers: []upcloud.Server{keep.Server, tmp.Server}}, nil)
+	mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: keep.UUID}).Return(&keep, nil)
+	mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: tmp.UUID}).Return(&tmp, nil)
+	mService.On("GetServerGroups", mock.Anything).Return(nil, nil)
+	mService.On("GetStorages", mock.Anything).Return(&upcloud.Storages{}, nil)
+	mService.On("GetTags").Return(&upcloud.Tags{Tags: []upcloud.Tag{{Name: "keep-tag"}}}, nil)
+	mService.On("GetKubernetesClusters", mock.Anything).Return(nil, nil)
+	mService.On("GetLoadBalancers", mock.Anything).Return(nil, nil)
+	mService.On("GetLoadBalancerCertificateBundles", mock.Anything).Return(nil, nil)
+	mService.On("DeleteServer", &request.DeleteServerRequest{UUID: tmp.UUID}).Return(nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeySelector, "!keep")
+	conf.Viper().Set(config.KeyYes, true)
+	command := commands.BuildCommand(PurgeCommand(), nil, conf)
+
+	_, err := mockexecute.MockExecute(command, &mService, conf)
+
+	assert.NoError(t, err)
+	mService.AssertCalled(t, "DeleteServer", &request.DeleteServerRequest{UUID: tmp.UUID})
+	mService.AssertNumberOfCalls(t, "DeleteServer", 1)
+	mService.AssertNotCalled(t, "DeleteTag", mock.Anything)
+}
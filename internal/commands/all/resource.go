@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/kubernetes"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	loadbalancercertificatebundle "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/certificatebundle"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/network"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/networkpeering"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage"
@@ -22,6 +24,8 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/server"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/servergroup"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/storage"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/utils"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
@@ -45,11 +49,14 @@ const (
 	typeTag               = "tag"
 )
 
+var resourceKeys = []string{"type", "uuid", "name", "labels"}
+
 type Resource struct {
-	Name  string
-	Type  string
-	UUID  string
-	State string
+	Name   string
+	Type   string
+	UUID   string
+	State  string
+	Labels []upcloud.Label
 }
 
 func (r Resource) Key() string {
@@ -162,7 +169,7 @@ func ListResources(exec commands.Executor, include, exclude []string) ([]Resourc
 	findResources(exec, &wg, returnChan, &resolver.CachingServerGroup{}, include, exclude)
 	findResources(exec, &wg, returnChan, &resolver.CachingStorage{Access: "private"}, include, exclude)
 	findResources(exec, &wg, returnChan, &cachingTag{}, include, exclude)
-	findResources(exec, &wg, returnChan, &cachingCertificateBundle{}, include, exclude)
+	findResources(exec, &wg, returnChan, &resolver.CachingCertificateBundle{}, include, exclude)
 
 	wg.Wait()
 	close(returnChan)
@@ -189,9 +196,10 @@ func getResource(val any) (Resource, error) {
 	switch v := val.(type) {
 	case upcloud.LoadBalancer:
 		return Resource{
-			Name: v.Name,
-			Type: typeLoadBalancer,
-			UUID: v.UUID,
+			Name:   v.Name,
+			Type:   typeLoadBalancer,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.LoadBalancerCertificateBundle:
 		return Resource{
@@ -201,39 +209,45 @@ func getResource(val any) (Resource, error) {
 		}, nil
 	case upcloud.KubernetesCluster:
 		return Resource{
-			Name: v.Name,
-			Type: typeKubernetes,
-			UUID: v.UUID,
+			Name:   v.Name,
+			Type:   typeKubernetes,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.Network:
 		return Resource{
-			Name: v.Name,
-			Type: typeNetwork,
-			UUID: v.UUID,
+			Name:   v.Name,
+			Type:   typeNetwork,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.NetworkPeering:
 		return Resource{
-			Name: v.Name,
-			Type: typeNetworkPeering,
-			UUID: v.UUID,
+			Name:   v.Name,
+			Type:   typeNetworkPeering,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.Router:
 		return Resource{
-			Name: v.Name,
-			Type: typeRouter,
-			UUID: v.UUID,
+			Name:   v.Name,
+			Type:   typeRouter,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.ManagedObjectStorage:
 		return Resource{
-			Name: v.Name,
-			Type: typeObjectStorage,
-			UUID: v.UUID,
+			Name:   v.Name,
+			Type:   typeObjectStorage,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.ManagedDatabase:
 		return Resource{
-			Name: v.Title,
-			Type: typeDatabase,
-			UUID: v.UUID,
+			Name:   v.Title,
+			Type:   typeDatabase,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.Server:
 		return Resource{
@@ -244,15 +258,17 @@ func getResource(val any) (Resource, error) {
 		}, nil
 	case upcloud.ServerGroup:
 		return Resource{
-			Name: v.Title,
-			Type: typeServerGroup,
-			UUID: v.UUID,
+			Name:   v.Title,
+			Type:   typeServerGroup,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.Storage:
 		return Resource{
-			Name: v.Title,
-			Type: typeStorage,
-			UUID: v.UUID,
+			Name:   v.Title,
+			Type:   typeStorage,
+			UUID:   v.UUID,
+			Labels: v.Labels,
 		}, nil
 	case upcloud.Tag:
 		return Resource{
@@ -263,6 +279,72 @@ func getResource(val any) (Resource, error) {
 	return Resource{}, fmt.Errorf("unsupported type %T", val)
 }
 
+// hasLabels checks if resources of the given type can have labels. Resources that can not have labels never match a label selector.
+func hasLabels(resourceType string) bool {
+	return resourceType != typeTag && resourceType != typeCertificateBundle
+}
+
+// labelsCell returns the labels of the resource as a table cell. Resources that can not have labels get an empty cell that does not match label selectors.
+func labelsCell(resource Resource) any {
+	if !hasLabels(resource.Type) {
+		return ""
+	}
+	return labels.Cell(resource.Labels)
+}
+
+// addServerLabels fetches the labels of the servers in resources. Server list does not include labels, so they are fetched from server details only when needed.
+func addServerLabels(exec commands.Executor, resources []Resource) error {
+	var servers []upcloud.Server
+	for _, resource := range resources {
+		if resource.Type == typeServer {
+			servers = append(servers, upcloud.Server{UUID: resource.UUID})
+		}
+	}
+	if len(servers) == 0 {
+		return nil
+	}
+
+	serverLabels, err := labels.ServerLabels(exec.Context(), exec.All(), servers)
+	if err != nil {
+		return err
+	}
+	for i, resource := range resources {
+		if resource.Type == typeServer {
+			resources[i].Labels = serverLabels[resource.UUID]
+		}
+	}
+	return nil
+}
+
+// filterResources returns the resources that match the filters and the label selector. Filters are matched against the same columns that are included in the output of `all list` command.
+func filterResources(resources []Resource, filters []filter.Filter, selector filter.Selector) ([]Resource, error) {
+	for _, f := range filters {
+		if !slices.Contains(resourceKeys, f.Key) {
+			return nil, fmt.Errorf("unknown column %q, available columns are: %s", f.Key, strings.Join(resourceKeys, ", "))
+		}
+	}
+
+	var filtered []Resource
+	for _, resource := range resources {
+		cell := labels.Cell(resource.Labels)
+		values := map[string]string{
+			"type":   resource.Type,
+			"uuid":   resource.UUID,
+			"name":   resource.Name,
+			"labels": cell.String(),
+		}
+
+		match := len(selector) == 0 || (hasLabels(resource.Type) && selector.Matches(cell.LabelMap()))
+		for _, f := range filters {
+			match = match && f.Matches(values[f.Key])
+		}
+		if match {
+			filtered = append(filtered, resource)
+		}
+	}
+	return filtered, nil
+}
+
 func deleteResource(exec commands.Executor, resource Resource) (err error) {
 	switch resource.Type {
 	case typeKubernetes:
@@ -288,7 +370,7 @@ func deleteResource(exec commands.Executor, resource Resource) (err error) {
 	case typeTag:
 		_, err = deleteTag(exec, resource.Name)
 	case typeCertificateBundle:
-		_, err = deleteCertificateBundle(exec, resource.UUID)
+		_, err = loadbalancercertificatebundle.Delete(exec, resource.UUID)
 	}
 	return err
 }
@@ -0,0 +1,132 @@
+package apply
+
+import (
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/pflag"
+)
+
+// ApplyCommand creates the "apply" command
+func ApplyCommand() commands.Command {
+	return &applyCommand{
+		BaseCommand: commands.New(
+			"apply",
+			"Create or update resources defined in a manifest file",
+			"upctl apply -f infra.yaml",
+			"upctl apply -f infra.yaml --plan-only",
+			"upctl apply -f infra.yaml --prune",
+			"cat infra.yaml | upctl apply -f -",
+		),
+	}
+}
+
+type applyCommand struct {
+	*commands.BaseCommand
+	file     string
+	prune    config.OptionalBoolean
+	planOnly config.OptionalBoolean
+	config   *config.Config
+}
+
+// InitCommand implements Command.InitCommand
+func (s *applyCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Create or update resources defined in a manifest file
+
+The manifest defines routers, networks, server groups, storages, and servers in YAML or JSON format. Existing resources are matched by name (title for server groups and storages, hostname for servers) and modified to match the manifest. Resources that do not exist are created. Resources are created in dependency order, so that, for example, a network can refer to a router and a server to a network defined in the same manifest.
+
+All resources created or modified by the manifest are labelled with ` + "`" + managedLabel + "=<name>`" + `. Use ` + "`" + `--prune` + "`" + ` to delete resources that have this label, but are no longer defined in the manifest. The resources to be deleted are listed and the deletion must be confirmed. Use ` + "`" + `--yes` + "`" + ` flag to skip the confirmation. When a server is deleted, only its OS storage is deleted with it, other attached storages are kept unless they are managed by the manifest. Use ` + "`" + `--plan-only` + "`" + ` to preview the changes without applying them.
+
+See the "Manage resources with a manifest" example in the documentation for a sample manifest.`)
+
+	flags := &pflag.FlagSet{}
+	flags.StringVarP(&s.file, "file", "f", "", "Path to the manifest file. Use `-` to read the manifest from stdin.")
+	config.AddToggleFlag(flags, &s.prune, "prune", false, "Delete resources that are labelled as managed by the manifest, but are no longer defined in it.")
+	config.AddToggleFlag(flags, &s.planOnly, "plan-only", false, "Only print the planned changes without applying them.")
+	s.AddFlags(flags)
+
+	commands.Must(s.Cobra().MarkFlagRequired("file"))
+	commands.Must(s.Cobra().MarkFlagFilename("file", "yaml", "yml", "json"))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (s *applyCommand) InitCommandWithConfig(cfg *config.Config) {
+	s.config = cfg
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *applyCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	m, err := readManifest(s.file, s.Cobra().InOrStdin())
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := newPlanner(exec, m)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := p.plan(exec, s.prune.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.planOnly.Value() {
+		var deletes []commands.ConfirmResource
+		for _, c := range changes {
+			if c.Action == actionDelete {
+				deletes = append(deletes, commands.ConfirmResource{Type: c.Type, UUID: c.UUID, Name: c.Name})
+			}
+		}
+		if err := commands.ConfirmDeletion(s, s.config, deletes); err != nil {
+			return nil, err
+		}
+
+		for _, c := range changes {
+			msg := c.progressMessage()
+			exec.PushProgressStarted(msg)
+
+			uuid, err := c.apply(exec)
+			if err != nil {
+				return commands.HandleError(exec, msg, err)
+			}
+
+			c.UUID = uuid
+			if c.Action != actionDelete {
+				p.setUUID(c.Type, c.Name, uuid)
+			}
+			exec.PushProgressSuccess(msg)
+		}
+	}
+
+	rows := make([]output.TableRow, 0, len(changes))
+	for _, c := range changes {
+		rows = append(rows, output.TableRow{
+			c.Action,
+			c.Type,
+			c.Name,
+			c.UUID,
+			strings.Join(c.Details, "\n"),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: changes,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "action", Header: "Action"},
+				{Key: "type", Header: "Type"},
+				{Key: "name", Header: "Name"},
+				{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				{Key: "details", Header: "Details", Colour: text.Colors{text.FgHiBlack}},
+			},
+			Rows:         rows,
+			EmptyMessage: "No changes, resources match the manifest.",
+		},
+	}, nil
+}
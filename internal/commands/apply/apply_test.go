@@ -0,0 +1,281 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `
+name: test
+routers:
+  - name: test-router
+networks:
+  - name: test-net
+    zone: fi-hel1
+    router: test-router
+    ip-networks:
+      - address: 10.0.0.0/24
+        dhcp: true
+`
+
+var (
+	testRouter = upcloud.Router{
+		UUID:   "04c0df35-2658-4b0c-8ad7-962090f4e92a",
+		Name:   "test-router",
+		Type:   "normal",
+		Labels: []upcloud.Label{{Key: managedLabel, Value: "test"}},
+	}
+	testNetwork = upcloud.Network{
+		UUID:   "03e5ca07-f36c-4957-a676-e001e40441eb",
+		Name:   "test-net",
+		Zone:   "fi-hel1",
+		Router: testRouter.UUID,
+		IPNetworks: upcloud.IPNetworkSlice{{
+			Address:          "10.0.0.0/24",
+			Family:           upcloud.IPAddressFamilyIPv4,
+			DHCP:             upcloud.True,
+			DHCPDefaultRoute: upcloud.False,
+			DHCPDns:          []string{"94.237.127.9", "94.237.40.9"},
+			Gateway:          "10.0.0.1",
+		}},
+		Labels: []upcloud.Label{{Key: managedLabel, Value: "test"}},
+	}
+	oldNetwork = upcloud.Network{
+		UUID:   "03b4a4a7-e29a-4d8c-9d4b-2c3b0a4f8a1e",
+		Name:   "old-net",
+		Zone:   "fi-hel1",
+		Labels: []upcloud.Label{{Key: managedLabel, Value: "test"}},
+	}
+)
+
+func writeManifest(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func newMockService(routers []upcloud.Router, networks []upcloud.Network) *smock.Service {
+	mService := &smock.Service{}
+	mService.On("GetRouters").Return(&upcloud.Routers{Routers: routers}, nil)
+	mService.On("GetNetworks").Return(&upcloud.Networks{Networks: networks}, nil)
+	mService.On("GetServerGroups", mock.Anything).Return(upcloud.ServerGroups{}, nil)
+	mService.On("GetStorages", mock.Anything).Return(&upcloud.Storages{}, nil)
+	mService.On("GetServers").Return(&upcloud.Servers{}, nil)
+	return mService
+}
+
+func TestApplyCommand_Create(t *testing.T) {
+	text.DisableColors()
+
+	mService := newMockService(nil, nil)
+	mService.On("CreateRouter", &request.CreateRouterRequest{
+		Name:   "test-router",
+		Labels: []upcloud.Label{{Key: managedLabel, Value: "test"}},
+	}).Return(&testRouter, nil)
+	mService.On("CreateNetwork", &request.CreateNetworkRequest{
+		Name:   "test-net",
+		Zone:   "fi-hel1",
+		Router: testRouter.UUID,
+		IPNetworks: upcloud.IPNetworkSlice{{
+			Address:          "10.0.0.0/24",
+			Family:           upcloud.IPAddressFamilyIPv4,
+			DHCP:             upcloud.True,
+			DHCPDefaultRoute: upcloud.False,
+		}},
+		Labels: []upcloud.Label{{Key: managedLabel, Value: "test"}},
+	}).Return(&testNetwork, nil)
+
+	conf := config.New()
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest)})
+
+	output, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	mService.AssertNumberOfCalls(t, "CreateRouter", 1)
+	mService.AssertNumberOfCalls(t, "CreateNetwork", 1)
+	assert.Contains(t, output, testRouter.UUID)
+	assert.Contains(t, output, testNetwork.UUID)
+}
+
+func TestApplyCommand_NoChanges(t *testing.T) {
+	text.DisableColors()
+
+	mService := newMockService([]upcloud.Router{testRouter}, []upcloud.Network{testNetwork})
+
+	conf := config.New()
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest)})
+
+	output, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "No changes, resources match the manifest.")
+}
+
+func TestApplyCommand_PlanOnly(t *testing.T) {
+	text.DisableColors()
+
+	router := testRouter
+	router.Labels = nil
+	mService := newMockService([]upcloud.Router{router}, []upcloud.Network{testNetwork, oldNetwork})
+
+	conf := config.New()
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest), "--plan-only", "--prune"})
+
+	output, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	mService.AssertNotCalled(t, "ModifyRouter", mock.Anything)
+	mService.AssertNotCalled(t, "DeleteNetwork", mock.Anything)
+	assert.Regexp(t, `modify +router +test-router`, output)
+	assert.Regexp(t, `delete +network +old-net`, output)
+}
+
+func TestApplyCommand_Prune(t *testing.T) {
+	text.DisableColors()
+
+	mService := newMockService([]upcloud.Router{testRouter}, []upcloud.Network{testNetwork, oldNetwork})
+	mService.On("DeleteNetwork", &request.DeleteNetworkRequest{UUID: oldNetwork.UUID}).Return(nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyYes, true)
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest), "--prune"})
+
+	_, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	mService.AssertNumberOfCalls(t, "DeleteNetwork", 1)
+}
+
+func TestApplyCommand_PruneNotConfirmed(t *testing.T) {
+	mService := newMockService([]upcloud.Router{testRouter}, []upcloud.Network{testNetwork, oldNetwork})
+
+	conf := config.New()
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest), "--prune"})
+
+	_, err := mockexecute.MockExecute(c, mService, conf)
+	assert.ErrorContains(t, err, "Use --yes flag to confirm the operation")
+
+	mService.AssertNotCalled(t, "DeleteNetwork", mock.Anything)
+}
+
+func TestApplyCommand_PruneServer(t *testing.T) {
+	text.DisableColors()
+
+	managed := []upcloud.Label{{Key: managedLabel, Value: "test"}}
+	oldData := upcloud.Storage{UUID: "01f3c1f8-2e39-4d2b-9a4b-7c0d3f3c1a01", Title: "old-data", Labels: managed}
+	server := upcloud.ServerDetails{
+		Server: upcloud.Server{
+			UUID:     "00798b85-efdc-41ca-8021-f6ef457b8531",
+			Hostname: "old-server",
+			State:    upcloud.ServerStateStopped,
+		},
+		Labels: managed,
+		StorageDevices: []upcloud.ServerStorageDevice{
+			{UUID: "01a6b0c8-9c11-4b4f-8f54-2c5a7d9e0b02", Title: "old-server-OS", Type: upcloud.StorageTypeDisk},
+			{UUID: "01c2f7a4-6d83-4e1a-b5f0-9e8d7c6b5a03", Title: "shared-data", Type: upcloud.StorageTypeDisk},
+			{UUID: oldData.UUID, Title: oldData.Title, Type: upcloud.StorageTypeDisk, Labels: managed},
+		},
+	}
+
+	mService := &smock.Service{}
+	mService.On("GetRouters").Return(&upcloud.Routers{Routers: []upcloud.Router{testRouter}}, nil)
+	mService.On("GetNetworks").Return(&upcloud.Networks{Networks: []upcloud.Network{testNetwork}}, nil)
+	mService.On("GetServerGroups", mock.Anything).Return(upcloud.ServerGroups{}, nil)
+	mService.On("GetStorages", mock.Anything).Return(&upcloud.Storages{Storages: []upcloud.Storage{oldData}}, nil)
+	mService.On("GetServers").Return(&upcloud.Servers{Servers: []upcloud.Server{server.Server}}, nil)
+	mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: server.UUID}).Return(&server, nil)
+	mService.On("DeleteServer", &request.DeleteServerRequest{UUID: server.UUID}).Return(nil)
+	mService.On("DeleteStorage", mock.Anything).Return(nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyYes, true)
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest), "--prune"})
+
+	output, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Regexp(t, `delete +server +old-server .*delete storage old-server-OS`, output)
+	mService.AssertNotCalled(t, "DeleteServerAndStorages", mock.Anything)
+	mService.AssertCalled(t, "DeleteServer", &request.DeleteServerRequest{UUID: server.UUID})
+	mService.AssertCalled(t, "DeleteStorage", &request.DeleteStorageRequest{UUID: server.StorageDevices[0].UUID})
+	mService.AssertCalled(t, "DeleteStorage", &request.DeleteStorageRequest{UUID: oldData.UUID})
+	mService.AssertNumberOfCalls(t, "DeleteStorage", 2)
+}
+
+func TestApplyCommand_ZoneChange(t *testing.T) {
+	network := testNetwork
+	network.Zone = "de-fra1"
+	mService := newMockService([]upcloud.Router{testRouter}, []upcloud.Network{network})
+
+	conf := config.New()
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest)})
+
+	_, err := mockexecute.MockExecute(c, mService, conf)
+	assert.EqualError(t, err, "network test-net: cannot move network from de-fra1 to fi-hel1, delete the network or rename it in the manifest")
+}
+
+func TestApplyCommand_IPNetworkChange(t *testing.T) {
+	text.DisableColors()
+
+	network := testNetwork
+	network.IPNetworks = slices.Clone(testNetwork.IPNetworks)
+	network.IPNetworks[0].DHCP = upcloud.False
+	mService := newMockService([]upcloud.Router{testRouter}, []upcloud.Network{network})
+	mService.On("ModifyNetwork", mock.Anything).Return(&testNetwork, nil)
+
+	conf := config.New()
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest)})
+
+	output, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "ip network 10.0.0.0/24 dhcp: false -> true")
+	mService.AssertCalled(t, "ModifyNetwork", &request.ModifyNetworkRequest{
+		UUID: testNetwork.UUID,
+		IPNetworks: upcloud.IPNetworkSlice{{
+			Address:          "10.0.0.0/24",
+			Family:           upcloud.IPAddressFamilyIPv4,
+			DHCP:             upcloud.True,
+			DHCPDefaultRoute: upcloud.False,
+		}},
+	})
+}
+
+func TestApplyCommand_IPNetworkAddressChange(t *testing.T) {
+	network := testNetwork
+	network.IPNetworks = slices.Clone(testNetwork.IPNetworks)
+	network.IPNetworks[0].Address = "10.0.1.0/24"
+	mService := newMockService([]upcloud.Router{testRouter}, []upcloud.Network{network})
+
+	conf := config.New()
+	c := commands.BuildCommand(ApplyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"-f", writeManifest(t, testManifest)})
+
+	_, err := mockexecute.MockExecute(c, mService, conf)
+	assert.EqualError(t, err, "network test-net: cannot change IP network address from 10.0.1.0/24 to 10.0.0.0/24, delete the network or rename it in the manifest")
+	mService.AssertNotCalled(t, "ModifyNetwork", mock.Anything)
+}
@@ -0,0 +1,313 @@
+package apply
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"go.yaml.in/yaml/v3"
+)
+
+// managedLabel is the label used to mark resources managed by a manifest. The value of the label is the name of the manifest.
+const managedLabel = "upctl-manifest"
+
+type manifest struct {
+	Name         string                `yaml:"name"`
+	Labels       map[string]string     `yaml:"labels"`
+	Routers      []routerManifest      `yaml:"routers"`
+	Networks     []networkManifest     `yaml:"networks"`
+	ServerGroups []serverGroupManifest `yaml:"server-groups"`
+	Storages     []storageManifest     `yaml:"storages"`
+	Servers      []serverManifest      `yaml:"servers"`
+}
+
+type routerManifest struct {
+	Name   string            `yaml:"name"`
+	Labels map[string]string `yaml:"labels"`
+}
+
+type networkManifest struct {
+	Name       string              `yaml:"name"`
+	Zone       string              `yaml:"zone"`
+	Router     string              `yaml:"router"`
+	IPNetworks []ipNetworkManifest `yaml:"ip-networks"`
+	Labels     map[string]string   `yaml:"labels"`
+}
+
+type ipNetworkManifest struct {
+	Address          string   `yaml:"address"`
+	Family           string   `yaml:"family"`
+	Gateway          string   `yaml:"gateway"`
+	DHCP             bool     `yaml:"dhcp"`
+	DHCPDefaultRoute bool     `yaml:"dhcp-default-route"`
+	DHCPDns          []string `yaml:"dhcp-dns"`
+}
+
+type serverGroupManifest struct {
+	Title        string            `yaml:"title"`
+	AntiAffinity string            `yaml:"anti-affinity"`
+	Labels       map[string]string `yaml:"labels"`
+}
+
+type storageManifest struct {
+	Title     string            `yaml:"title"`
+	Zone      string            `yaml:"zone"`
+	Size      int               `yaml:"size"`
+	Tier      string            `yaml:"tier"`
+	Encrypted bool              `yaml:"encrypted"`
+	Labels    map[string]string `yaml:"labels"`
+}
+
+type serverManifest struct {
+	Hostname      string                  `yaml:"hostname"`
+	Title         string                  `yaml:"title"`
+	Zone          string                  `yaml:"zone"`
+	Plan          string                  `yaml:"plan"`
+	OS            string                  `yaml:"os"`
+	OSStorageSize int                     `yaml:"os-storage-size"`
+	SSHKeys       []string                `yaml:"ssh-keys"`
+	Username      string                  `yaml:"username"`
+	UserData      string                  `yaml:"user-data"`
+	ServerGroup   string                  `yaml:"server-group"`
+	Networks      []serverNetworkManifest `yaml:"networks"`
+	Storages      []string                `yaml:"storages"`
+	FirewallRules []firewallRuleManifest  `yaml:"firewall-rules"`
+	Labels        map[string]string       `yaml:"labels"`
+}
+
+type serverNetworkManifest struct {
+	Type      string `yaml:"type"`
+	Network   string `yaml:"network"`
+	Family    string `yaml:"family"`
+	IPAddress string `yaml:"ip-address"`
+}
+
+type firewallRuleManifest struct {
+	Direction               string `yaml:"direction"`
+	Action                  string `yaml:"action"`
+	Family                  string `yaml:"family"`
+	Protocol                string `yaml:"protocol"`
+	ICMPType                string `yaml:"icmp-type"`
+	SourceAddressStart      string `yaml:"source-address-start"`
+	SourceAddressEnd        string `yaml:"source-address-end"`
+	SourcePortStart         string `yaml:"source-port-start"`
+	SourcePortEnd           string `yaml:"source-port-end"`
+	DestinationAddressStart string `yaml:"destination-address-start"`
+	DestinationAddressEnd   string `yaml:"destination-address-end"`
+	DestinationPortStart    string `yaml:"destination-port-start"`
+	DestinationPortEnd      string `yaml:"destination-port-end"`
+	Comment                 string `yaml:"comment"`
+}
+
+// readManifest reads and validates a manifest from the given path. If path is -, the manifest is read from stdin.
+func readManifest(path string, stdin io.Reader) (*manifest, error) {
+	var r io.Reader = stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read manifest: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return parseManifest(r)
+}
+
+// parseManifest parses a manifest in YAML or JSON format. Unknown keys are considered errors to catch typos early.
+func parseManifest(r io.Reader) (*manifest, error) {
+	m := &manifest{}
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(m); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("manifest is empty")
+		}
+		return nil, fmt.Errorf("cannot parse manifest: %w", err)
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *manifest) validate() error {
+	var errs []error
+	if m.Name == "" {
+		errs = append(errs, fmt.Errorf("name is required, it is used to label the resources managed by the manifest"))
+	}
+
+	checkUnique := func(typ string, names []string) {
+		seen := make(map[string]bool)
+		for _, name := range names {
+			if name == "" {
+				errs = append(errs, fmt.Errorf("%s is missing a name", typ))
+				continue
+			}
+			if seen[name] {
+				errs = append(errs, fmt.Errorf("%s %s is defined multiple times", typ, name))
+			}
+			seen[name] = true
+		}
+	}
+	checkUnique(typeRouter, m.routerNames())
+	checkUnique(typeNetwork, m.networkNames())
+	checkUnique(typeServerGroup, m.serverGroupNames())
+	checkUnique(typeStorage, m.storageNames())
+	checkUnique(typeServer, m.serverNames())
+
+	for _, network := range m.Networks {
+		if network.Zone == "" {
+			errs = append(errs, fmt.Errorf("network %s: zone is required", network.Name))
+		}
+		if len(network.IPNetworks) == 0 {
+			errs = append(errs, fmt.Errorf("network %s: at least one ip-network is required", network.Name))
+		}
+	}
+	for _, serverGroup := range m.ServerGroups {
+		switch upcloud.ServerGroupAntiAffinityPolicy(serverGroup.AntiAffinity) {
+		case "", upcloud.ServerGroupAntiAffinityPolicyStrict, upcloud.ServerGroupAntiAffinityPolicyBestEffort, upcloud.ServerGroupAntiAffinityPolicyOff:
+		default:
+			errs = append(errs, fmt.Errorf("server-group %s: anti-affinity must be one of strict, yes or no", serverGroup.Title))
+		}
+	}
+	for _, storage := range m.Storages {
+		if storage.Zone == "" {
+			errs = append(errs, fmt.Errorf("storage %s: zone is required", storage.Title))
+		}
+		if storage.Size <= 0 {
+			errs = append(errs, fmt.Errorf("storage %s: size is required", storage.Title))
+		}
+	}
+	for _, server := range m.Servers {
+		for key, value := range map[string]string{"zone": server.Zone, "plan": server.Plan, "os": server.OS} {
+			if value == "" {
+				errs = append(errs, fmt.Errorf("server %s: %s is required", server.Hostname, key))
+			}
+		}
+		for _, network := range server.Networks {
+			if network.Type == "" {
+				errs = append(errs, fmt.Errorf("server %s: network type is required", server.Hostname))
+			}
+			if network.Type == upcloud.NetworkTypePrivate && network.Network == "" {
+				errs = append(errs, fmt.Errorf("server %s: network is required for private network interfaces", server.Hostname))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *manifest) routerNames() (names []string) {
+	for _, router := range m.Routers {
+		names = append(names, router.Name)
+	}
+	return
+}
+
+func (m *manifest) networkNames() (names []string) {
+	for _, network := range m.Networks {
+		names = append(names, network.Name)
+	}
+	return
+}
+
+func (m *manifest) serverGroupNames() (names []string) {
+	for _, serverGroup := range m.ServerGroups {
+		names = append(names, serverGroup.Title)
+	}
+	return
+}
+
+func (m *manifest) storageNames() (names []string) {
+	for _, storage := range m.Storages {
+		names = append(names, storage.Title)
+	}
+	return
+}
+
+func (m *manifest) serverNames() (names []string) {
+	for _, server := range m.Servers {
+		names = append(names, server.Hostname)
+	}
+	return
+}
+
+// labels returns the labels for a resource: labels common to all resources in the manifest, labels of the resource, and the managed label. The labels are sorted by key.
+func (m *manifest) labels(resourceLabels map[string]string) []upcloud.Label {
+	all := make(map[string]string)
+	maps.Copy(all, m.Labels)
+	maps.Copy(all, resourceLabels)
+	all[managedLabel] = m.Name
+
+	labels := make([]upcloud.Label, 0, len(all))
+	for _, key := range slices.Sorted(maps.Keys(all)) {
+		labels = append(labels, upcloud.Label{Key: key, Value: all[key]})
+	}
+	return labels
+}
+
+// isManagedBy returns true if the labels contain the managed label with the given manifest name.
+func isManagedBy(labels []upcloud.Label, name string) bool {
+	for _, label := range labels {
+		if label.Key == managedLabel && label.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// labelsEqual compares labels ignoring their order.
+func labelsEqual(a, b []upcloud.Label) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortLabels := func(labels []upcloud.Label) []upcloud.Label {
+		sorted := slices.Clone(labels)
+		slices.SortFunc(sorted, func(x, y upcloud.Label) int {
+			return strings.Compare(x.Key, y.Key)
+		})
+		return sorted
+	}
+	return slices.Equal(sortLabels(a), sortLabels(b))
+}
+
+func (f firewallRuleManifest) toFirewallRule() upcloud.FirewallRule {
+	return upcloud.FirewallRule{
+		Direction:               f.Direction,
+		Action:                  f.Action,
+		Family:                  f.Family,
+		Protocol:                f.Protocol,
+		ICMPType:                f.ICMPType,
+		SourceAddressStart:      f.SourceAddressStart,
+		SourceAddressEnd:        f.SourceAddressEnd,
+		SourcePortStart:         f.SourcePortStart,
+		SourcePortEnd:           f.SourcePortEnd,
+		DestinationAddressStart: f.DestinationAddressStart,
+		DestinationAddressEnd:   f.DestinationAddressEnd,
+		DestinationPortStart:    f.DestinationPortStart,
+		DestinationPortEnd:      f.DestinationPortEnd,
+		Comment:                 f.Comment,
+	}
+}
+
+func (n ipNetworkManifest) toIPNetwork() upcloud.IPNetwork {
+	family := n.Family
+	if family == "" {
+		family = upcloud.IPAddressFamilyIPv4
+	}
+	return upcloud.IPNetwork{
+		Address:          n.Address,
+		Family:           family,
+		Gateway:          n.Gateway,
+		DHCP:             upcloud.FromBool(n.DHCP),
+		DHCPDefaultRoute: upcloud.FromBool(n.DHCPDefaultRoute),
+		DHCPDns:          n.DHCPDns,
+	}
+}
@@ -0,0 +1,106 @@
+package apply
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManifest(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		manifest string
+		errors   []string
+	}{
+		{
+			name: "valid manifest",
+			manifest: `
+name: test
+routers:
+  - name: test-router
+networks:
+  - name: test-net
+    zone: fi-hel1
+    router: test-router
+    ip-networks:
+      - address: 10.0.0.0/24
+servers:
+  - hostname: test-server
+    zone: fi-hel1
+    plan: 1xCPU-1GB
+    os: Debian GNU/Linux 12 (Bookworm)
+    networks:
+      - type: private
+        network: test-net
+`,
+		},
+		{
+			name:     "empty manifest",
+			manifest: "",
+			errors:   []string{"manifest is empty"},
+		},
+		{
+			name:     "unknown field",
+			manifest: "name: test\nrouter:\n  - name: typo\n",
+			errors:   []string{"field router not found"},
+		},
+		{
+			name: "invalid manifest",
+			manifest: `
+networks:
+  - name: test-net
+  - name: test-net
+server-groups:
+  - title: test-group
+    anti-affinity: maybe
+storages:
+  - title: test-storage
+servers:
+  - hostname: test-server
+    networks:
+      - type: private
+`,
+			errors: []string{
+				"name is required",
+				"network test-net is defined multiple times",
+				"network test-net: zone is required",
+				"network test-net: at least one ip-network is required",
+				"server-group test-group: anti-affinity must be one of strict, yes or no",
+				"storage test-storage: zone is required",
+				"storage test-storage: size is required",
+				"server test-server: plan is required",
+				"server test-server: network is required for private network interfaces",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := parseManifest(strings.NewReader(test.manifest))
+			if len(test.errors) == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, "test", m.Name)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expected := range test.errors {
+				assert.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestManifest_Labels(t *testing.T) {
+	m := manifest{
+		Name:   "test",
+		Labels: map[string]string{"env": "dev", "team": "a"},
+	}
+
+	assert.Equal(t, []upcloud.Label{
+		{Key: "env", Value: "prod"},
+		{Key: "team", Value: "a"},
+		{Key: managedLabel, Value: "test"},
+	}, m.labels(map[string]string{"env": "prod"}))
+}
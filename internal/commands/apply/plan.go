@@ -0,0 +1,235 @@
+package apply
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+)
+
+const (
+	typeRouter      = "router"
+	typeNetwork     = "network"
+	typeServerGroup = "server-group"
+	typeStorage     = "storage"
+	typeServer      = "server"
+
+	actionCreate = "create"
+	actionModify = "modify"
+	actionDelete = "delete"
+)
+
+// change is a single planned operation. Changes are executed in the order they are planned.
+type change struct {
+	Action  string   `json:"action"`
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	UUID    string   `json:"uuid,omitempty"`
+	Details []string `json:"details,omitempty"`
+
+	// apply executes the change and returns the UUID of the affected resource.
+	apply func(exec commands.Executor) (string, error)
+}
+
+func (c *change) progressMessage() string {
+	verb := map[string]string{
+		actionCreate: "Creating",
+		actionModify: "Modifying",
+		actionDelete: "Deleting",
+	}[c.Action]
+	return fmt.Sprintf("%s %s %s", verb, c.Type, c.Name)
+}
+
+// planner computes the changes needed to make the current state of the account match the manifest.
+type planner struct {
+	manifest *manifest
+
+	routers      resolver.CachingRouter
+	networks     resolver.CachingNetwork
+	serverGroups resolver.CachingServerGroup
+	storages     resolver.CachingStorage
+	servers      resolver.CachingServer
+
+	resolvers map[string]resolver.Resolver
+	// uuids contains the UUIDs of existing resources referenced in the manifest by type and name. UUIDs of created resources are added when the changes are executed.
+	uuids map[string]map[string]string
+}
+
+func newPlanner(exec commands.Executor, m *manifest) (*planner, error) {
+	p := &planner{
+		manifest:  m,
+		routers:   resolver.CachingRouter{Type: "normal"},
+		storages:  resolver.CachingStorage{Access: upcloud.StorageAccessPrivate},
+		resolvers: make(map[string]resolver.Resolver),
+		uuids:     make(map[string]map[string]string),
+	}
+
+	for typ, provider := range map[string]resolver.ResolutionProvider{
+		typeRouter:      &p.routers,
+		typeNetwork:     &p.networks,
+		typeServerGroup: &p.serverGroups,
+		typeStorage:     &p.storages,
+		typeServer:      &p.servers,
+	} {
+		resolve, err := provider.Get(exec.Context(), exec.All())
+		if err != nil {
+			return nil, fmt.Errorf("cannot list %ss: %w", typ, err)
+		}
+		p.resolvers[typ] = resolve
+	}
+
+	return p, nil
+}
+
+func (p *planner) setUUID(typ, name, uuid string) {
+	if p.uuids[typ] == nil {
+		p.uuids[typ] = make(map[string]string)
+	}
+	p.uuids[typ][name] = uuid
+}
+
+// uuid returns the UUID of a referenced resource. This is used when executing the changes as the referenced resource might have been created by a previous change.
+func (p *planner) uuid(typ, name string) (string, error) {
+	if uuid, ok := p.uuids[typ][name]; ok {
+		return uuid, nil
+	}
+	return "", fmt.Errorf("%s %s has not been created", typ, name)
+}
+
+// checkReference validates that a resource referenced in the manifest is either defined in the manifest or exists in the account.
+func (p *planner) checkReference(typ, nameOrUUID string, defined []string) error {
+	if nameOrUUID == "" || slices.Contains(defined, nameOrUUID) {
+		return nil
+	}
+
+	resolved := p.resolvers[typ](nameOrUUID)
+	uuid, err := resolved.GetOnly()
+	if err != nil {
+		return fmt.Errorf("cannot resolve %s %s: %w", typ, nameOrUUID, err)
+	}
+	p.setUUID(typ, nameOrUUID, uuid)
+	return nil
+}
+
+// findExisting finds a resource that has exactly the given name. Resources are listed with a wildcard as the resolvers also match names case-insensitively and by prefix.
+func findExisting[T any](provider resolver.CachingResolutionProvider[T], resolve resolver.Resolver, name string, names func(T) []string) (*T, error) {
+	all, err := listAll(provider, resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *T
+	for _, val := range all {
+		if slices.Contains(names(val), name) {
+			if found != nil {
+				return nil, resolver.AmbiguousResolutionError(name)
+			}
+			found = &val
+		}
+	}
+	return found, nil
+}
+
+// listAll returns all resources available through the given resolver.
+func listAll[T any](provider resolver.CachingResolutionProvider[T], resolve resolver.Resolver) ([]T, error) {
+	resolved := resolve("*")
+	uuids, err := resolved.GetAll()
+	if err != nil {
+		if errors.Is(err, resolver.NotFoundError("*")) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	all := make([]T, 0, len(uuids))
+	for _, uuid := range uuids {
+		val, err := provider.GetCached(uuid)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, val)
+	}
+	return all, nil
+}
+
+// findManaged finds resources that are labelled as managed by the manifest, but are no longer defined in it.
+func findManaged[T any](provider resolver.CachingResolutionProvider[T], resolve resolver.Resolver, manifestName string, defined []string, labels func(T) []upcloud.Label, name func(T) string) ([]T, error) {
+	all, err := listAll(provider, resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	var managed []T
+	for _, val := range all {
+		if isManagedBy(labels(val), manifestName) && !slices.Contains(defined, name(val)) {
+			managed = append(managed, val)
+		}
+	}
+	slices.SortFunc(managed, func(a, b T) int {
+		return strings.Compare(name(a), name(b))
+	})
+	return managed, nil
+}
+
+func formatLabels(labels []upcloud.Label) string {
+	strs := make([]string, len(labels))
+	for i, label := range labels {
+		strs[i] = fmt.Sprintf("%s=%s", label.Key, label.Value)
+	}
+	return strings.Join(strs, ", ")
+}
+
+// plan returns the changes in dependency order: routers, networks, server groups, storages, and servers are created or modified first. Resources to prune are deleted last in reverse order.
+func (p *planner) plan(exec commands.Executor, prune bool) ([]*change, error) {
+	var changes []*change
+	add := func(c *change, err error) error {
+		if err != nil {
+			return err
+		}
+		if c != nil {
+			changes = append(changes, c)
+		}
+		return nil
+	}
+
+	for _, r := range p.manifest.Routers {
+		if err := add(p.planRouter(r)); err != nil {
+			return nil, fmt.Errorf("router %s: %w", r.Name, err)
+		}
+	}
+	for _, n := range p.manifest.Networks {
+		if err := add(p.planNetwork(n)); err != nil {
+			return nil, fmt.Errorf("network %s: %w", n.Name, err)
+		}
+	}
+	for _, g := range p.manifest.ServerGroups {
+		if err := add(p.planServerGroup(g)); err != nil {
+			return nil, fmt.Errorf("server-group %s: %w", g.Title, err)
+		}
+	}
+	for _, s := range p.manifest.Storages {
+		if err := add(p.planStorage(s)); err != nil {
+			return nil, fmt.Errorf("storage %s: %w", s.Title, err)
+		}
+	}
+	for _, s := range p.manifest.Servers {
+		if err := add(p.planServer(exec, s)); err != nil {
+			return nil, fmt.Errorf("server %s: %w", s.Hostname, err)
+		}
+	}
+
+	if prune {
+		deletes, err := p.planPrune(exec)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, deletes...)
+	}
+
+	return changes, nil
+}
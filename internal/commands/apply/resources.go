@@ -0,0 +1,833 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/storage"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+const waitTimeout = 15 * time.Minute
+
+func (p *planner) planRouter(r routerManifest) (*change, error) {
+	labels := p.manifest.labels(r.Labels)
+	existing, err := findExisting(&p.routers, p.resolvers[typeRouter], r.Name, func(v upcloud.Router) []string {
+		return []string{v.Name}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return &change{
+			Action:  actionCreate,
+			Type:    typeRouter,
+			Name:    r.Name,
+			Details: []string{"labels: " + formatLabels(labels)},
+			apply: func(exec commands.Executor) (string, error) {
+				res, err := exec.Network().CreateRouter(exec.Context(), &request.CreateRouterRequest{
+					Name:   r.Name,
+					Labels: labels,
+				})
+				if err != nil {
+					return "", err
+				}
+				return res.UUID, nil
+			},
+		}, nil
+	}
+
+	p.setUUID(typeRouter, r.Name, existing.UUID)
+	if labelsEqual(existing.Labels, labels) {
+		return nil, nil
+	}
+
+	return &change{
+		Action:  actionModify,
+		Type:    typeRouter,
+		Name:    r.Name,
+		UUID:    existing.UUID,
+		Details: []string{"labels: " + formatLabels(labels)},
+		apply: func(exec commands.Executor) (string, error) {
+			_, err := exec.Network().ModifyRouter(exec.Context(), &request.ModifyRouterRequest{
+				UUID:   existing.UUID,
+				Name:   existing.Name,
+				Labels: &labels,
+			})
+			return existing.UUID, err
+		},
+	}, nil
+}
+
+func (p *planner) planNetwork(n networkManifest) (*change, error) {
+	if err := p.checkReference(typeRouter, n.Router, p.manifest.routerNames()); err != nil {
+		return nil, err
+	}
+
+	labels := p.manifest.labels(n.Labels)
+	ipNetworks := make(upcloud.IPNetworkSlice, len(n.IPNetworks))
+	for i, ipNetwork := range n.IPNetworks {
+		ipNetworks[i] = ipNetwork.toIPNetwork()
+	}
+
+	existing, err := findExisting(&p.networks, p.resolvers[typeNetwork], n.Name, func(v upcloud.Network) []string {
+		return []string{v.Name}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		details := []string{"zone: " + n.Zone}
+		if n.Router != "" {
+			details = append(details, "router: "+n.Router)
+		}
+		details = append(details, "labels: "+formatLabels(labels))
+
+		return &change{
+			Action:  actionCreate,
+			Type:    typeNetwork,
+			Name:    n.Name,
+			Details: details,
+			apply: func(exec commands.Executor) (string, error) {
+				req := request.CreateNetworkRequest{
+					Name:       n.Name,
+					Zone:       n.Zone,
+					IPNetworks: ipNetworks,
+					Labels:     labels,
+				}
+				if n.Router != "" {
+					routerUUID, err := p.uuid(typeRouter, n.Router)
+					if err != nil {
+						return "", err
+					}
+					req.Router = routerUUID
+				}
+
+				res, err := exec.Network().CreateNetwork(exec.Context(), &req)
+				if err != nil {
+					return "", err
+				}
+				return res.UUID, nil
+			},
+		}, nil
+	}
+
+	p.setUUID(typeNetwork, n.Name, existing.UUID)
+	if existing.Zone != n.Zone {
+		return nil, fmt.Errorf("cannot move network from %s to %s, delete the network or rename it in the manifest", existing.Zone, n.Zone)
+	}
+
+	var details []string
+	routerUUID, routerKnown := p.uuids[typeRouter][n.Router]
+	routerChanged := (n.Router == "" && existing.Router != "") || (n.Router != "" && (!routerKnown || routerUUID != existing.Router))
+	if routerChanged {
+		if n.Router == "" {
+			details = append(details, "router: detach")
+		} else {
+			details = append(details, "router: "+n.Router)
+		}
+	}
+	labelsChanged := !labelsEqual(existing.Labels, labels)
+	if labelsChanged {
+		details = append(details, "labels: "+formatLabels(labels))
+	}
+	ipNetworkDetails, err := diffIPNetworks(existing.IPNetworks, ipNetworks)
+	if err != nil {
+		return nil, err
+	}
+	ipNetworksChanged := len(ipNetworkDetails) > 0
+	details = append(details, ipNetworkDetails...)
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	return &change{
+		Action:  actionModify,
+		Type:    typeNetwork,
+		Name:    n.Name,
+		UUID:    existing.UUID,
+		Details: details,
+		apply: func(exec commands.Executor) (string, error) {
+			svc := exec.Network()
+			if labelsChanged || ipNetworksChanged {
+				req := request.ModifyNetworkRequest{UUID: existing.UUID}
+				if labelsChanged {
+					req.Labels = &labels
+				}
+				if ipNetworksChanged {
+					req.IPNetworks = ipNetworks
+				}
+				if _, err := svc.ModifyNetwork(exec.Context(), &req); err != nil {
+					return "", err
+				}
+			}
+			if routerChanged && n.Router == "" {
+				if err := svc.DetachNetworkRouter(exec.Context(), &request.DetachNetworkRouterRequest{NetworkUUID: existing.UUID}); err != nil {
+					return "", err
+				}
+			}
+			if routerChanged && n.Router != "" {
+				routerUUID, err := p.uuid(typeRouter, n.Router)
+				if err != nil {
+					return "", err
+				}
+				if err := svc.AttachNetworkRouter(exec.Context(), &request.AttachNetworkRouterRequest{
+					NetworkUUID: existing.UUID,
+					RouterUUID:  routerUUID,
+				}); err != nil {
+					return "", err
+				}
+			}
+			return existing.UUID, nil
+		},
+	}, nil
+}
+
+func (p *planner) planServerGroup(g serverGroupManifest) (*change, error) {
+	labels := upcloud.LabelSlice(p.manifest.labels(g.Labels))
+	policy := upcloud.ServerGroupAntiAffinityPolicy(g.AntiAffinity)
+
+	existing, err := findExisting(&p.serverGroups, p.resolvers[typeServerGroup], g.Title, func(v upcloud.ServerGroup) []string {
+		return []string{v.Title}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		details := []string{}
+		if policy != "" {
+			details = append(details, "anti-affinity: "+string(policy))
+		}
+		details = append(details, "labels: "+formatLabels(labels))
+
+		return &change{
+			Action:  actionCreate,
+			Type:    typeServerGroup,
+			Name:    g.Title,
+			Details: details,
+			apply: func(exec commands.Executor) (string, error) {
+				res, err := exec.All().CreateServerGroup(exec.Context(), &request.CreateServerGroupRequest{
+					Title:              g.Title,
+					AntiAffinityPolicy: policy,
+					Labels:             &labels,
+				})
+				if err != nil {
+					return "", err
+				}
+				return res.UUID, nil
+			},
+		}, nil
+	}
+
+	p.setUUID(typeServerGroup, g.Title, existing.UUID)
+
+	req := request.ModifyServerGroupRequest{UUID: existing.UUID}
+	var details []string
+	if policy != "" && policy != existing.AntiAffinityPolicy {
+		req.AntiAffinityPolicy = policy
+		details = append(details, fmt.Sprintf("anti-affinity: %s -> %s", existing.AntiAffinityPolicy, policy))
+	}
+	if !labelsEqual(existing.Labels, labels) {
+		req.Labels = &labels
+		details = append(details, "labels: "+formatLabels(labels))
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	return &change{
+		Action:  actionModify,
+		Type:    typeServerGroup,
+		Name:    g.Title,
+		UUID:    existing.UUID,
+		Details: details,
+		apply: func(exec commands.Executor) (string, error) {
+			_, err := exec.All().ModifyServerGroup(exec.Context(), &req)
+			return existing.UUID, err
+		},
+	}, nil
+}
+
+func (p *planner) planStorage(s storageManifest) (*change, error) {
+	labels := p.manifest.labels(s.Labels)
+
+	existing, err := findExisting(&p.storages, p.resolvers[typeStorage], s.Title, func(v upcloud.Storage) []string {
+		return []string{v.Title}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		details := []string{
+			"zone: " + s.Zone,
+			fmt.Sprintf("size: %d GiB", s.Size),
+		}
+		if s.Tier != "" {
+			details = append(details, "tier: "+s.Tier)
+		}
+		details = append(details, "labels: "+formatLabels(labels))
+
+		return &change{
+			Action:  actionCreate,
+			Type:    typeStorage,
+			Name:    s.Title,
+			Details: details,
+			apply: func(exec commands.Executor) (string, error) {
+				res, err := exec.Storage().CreateStorage(exec.Context(), &request.CreateStorageRequest{
+					Title:     s.Title,
+					Zone:      s.Zone,
+					Size:      s.Size,
+					Tier:      s.Tier,
+					Encrypted: upcloud.FromBool(s.Encrypted),
+					Labels:    labels,
+				})
+				if err != nil {
+					return "", err
+				}
+
+				// Storage must be online before it can be attached to a server.
+				ctx, cancel := context.WithTimeout(exec.Context(), waitTimeout)
+				defer cancel()
+				if _, err := exec.Storage().WaitForStorageState(ctx, &request.WaitForStorageStateRequest{
+					UUID:         res.UUID,
+					DesiredState: upcloud.StorageStateOnline,
+				}); err != nil {
+					return res.UUID, err
+				}
+				return res.UUID, nil
+			},
+		}, nil
+	}
+
+	p.setUUID(typeStorage, s.Title, existing.UUID)
+	if existing.Zone != s.Zone {
+		return nil, fmt.Errorf("cannot move storage from %s to %s, clone the storage or rename it in the manifest", existing.Zone, s.Zone)
+	}
+	if s.Size < existing.Size {
+		return nil, fmt.Errorf("cannot shrink storage from %d GiB to %d GiB", existing.Size, s.Size)
+	}
+
+	req := request.ModifyStorageRequest{UUID: existing.UUID}
+	var details []string
+	if s.Size > existing.Size {
+		req.Size = s.Size
+		details = append(details, fmt.Sprintf("size: %d GiB -> %d GiB", existing.Size, s.Size))
+	}
+	if !labelsEqual(existing.Labels, labels) {
+		req.Labels = &labels
+		details = append(details, "labels: "+formatLabels(labels))
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	return &change{
+		Action:  actionModify,
+		Type:    typeStorage,
+		Name:    s.Title,
+		UUID:    existing.UUID,
+		Details: details,
+		apply: func(exec commands.Executor) (string, error) {
+			_, err := exec.Storage().ModifyStorage(exec.Context(), &req)
+			return existing.UUID, err
+		},
+	}, nil
+}
+
+func (p *planner) planServer(exec commands.Executor, s serverManifest) (*change, error) {
+	if err := p.checkReference(typeServerGroup, s.ServerGroup, p.manifest.serverGroupNames()); err != nil {
+		return nil, err
+	}
+	for _, network := range s.Networks {
+		if err := p.checkReference(typeNetwork, network.Network, p.manifest.networkNames()); err != nil {
+			return nil, err
+		}
+	}
+	for _, strg := range s.Storages {
+		if err := p.checkReference(typeStorage, strg, p.manifest.storageNames()); err != nil {
+			return nil, err
+		}
+	}
+
+	title := s.Title
+	if title == "" {
+		title = s.Hostname
+	}
+	labels := upcloud.LabelSlice(p.manifest.labels(s.Labels))
+	var firewallRules request.FirewallRuleSlice
+	for _, rule := range s.FirewallRules {
+		firewallRules = append(firewallRules, rule.toFirewallRule())
+	}
+
+	existing, err := findExisting(&p.servers, p.resolvers[typeServer], s.Hostname, func(v upcloud.Server) []string {
+		return []string{v.Hostname}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		details := []string{
+			"zone: " + s.Zone,
+			"plan: " + s.Plan,
+			"os: " + s.OS,
+		}
+		if len(firewallRules) > 0 {
+			details = append(details, fmt.Sprintf("firewall rules: %d", len(firewallRules)))
+		}
+		details = append(details, "labels: "+formatLabels(labels))
+
+		return &change{
+			Action:  actionCreate,
+			Type:    typeServer,
+			Name:    s.Hostname,
+			Details: details,
+			apply: func(exec commands.Executor) (string, error) {
+				req, err := p.createServerRequest(exec, s, title, labels)
+				if err != nil {
+					return "", err
+				}
+				if len(firewallRules) > 0 {
+					req.Firewall = "on"
+				}
+
+				res, err := exec.Server().CreateServer(exec.Context(), req)
+				if err != nil {
+					return "", err
+				}
+
+				if len(firewallRules) > 0 {
+					if err := exec.Firewall().CreateFirewallRules(exec.Context(), &request.CreateFirewallRulesRequest{
+						ServerUUID:    res.UUID,
+						FirewallRules: firewallRules,
+					}); err != nil {
+						return res.UUID, err
+					}
+				}
+				return res.UUID, nil
+			},
+		}, nil
+	}
+
+	p.setUUID(typeServer, s.Hostname, existing.UUID)
+	if existing.Zone != s.Zone {
+		return nil, fmt.Errorf("cannot move server from %s to %s, use upctl server relocate", existing.Zone, s.Zone)
+	}
+
+	details, err := exec.Server().GetServerDetails(exec.Context(), &request.GetServerDetailsRequest{UUID: existing.UUID})
+	if err != nil {
+		return nil, err
+	}
+
+	req := request.ModifyServerRequest{UUID: existing.UUID}
+	var changes []string
+	if title != details.Title {
+		req.Title = title
+		changes = append(changes, fmt.Sprintf("title: %s -> %s", details.Title, title))
+	}
+	if s.Plan != details.Plan {
+		if details.State != upcloud.ServerStateStopped {
+			return nil, fmt.Errorf("cannot change plan from %s to %s while the server is %s, stop the server first", details.Plan, s.Plan, details.State)
+		}
+		req.Plan = s.Plan
+		changes = append(changes, fmt.Sprintf("plan: %s -> %s", details.Plan, s.Plan))
+	}
+	if !labelsEqual(details.Labels, labels) {
+		req.Labels = &labels
+		changes = append(changes, "labels: "+formatLabels(labels))
+	}
+
+	// Firewall rules are managed only if defined in the manifest. Use an empty list to remove all rules.
+	replaceFirewallRules := false
+	if s.FirewallRules != nil {
+		current, err := exec.Firewall().GetFirewallRules(exec.Context(), &request.GetFirewallRulesRequest{ServerUUID: existing.UUID})
+		if err != nil {
+			return nil, err
+		}
+		if !firewallRulesEqual(current.FirewallRules, firewallRules) {
+			replaceFirewallRules = true
+			changes = append(changes, fmt.Sprintf("firewall rules: %d -> %d", len(current.FirewallRules), len(firewallRules)))
+		}
+		if len(firewallRules) > 0 && details.Firewall != "on" {
+			req.Firewall = "on"
+			changes = append(changes, "firewall: on")
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	modifyServer := len(changes) > 1 || !replaceFirewallRules
+	return &change{
+		Action:  actionModify,
+		Type:    typeServer,
+		Name:    s.Hostname,
+		UUID:    existing.UUID,
+		Details: changes,
+		apply: func(exec commands.Executor) (string, error) {
+			if modifyServer {
+				if _, err := exec.Server().ModifyServer(exec.Context(), &req); err != nil {
+					return "", err
+				}
+			}
+			if replaceFirewallRules {
+				if err := exec.Firewall().CreateFirewallRules(exec.Context(), &request.CreateFirewallRulesRequest{
+					ServerUUID:    existing.UUID,
+					FirewallRules: firewallRules,
+				}); err != nil {
+					return "", err
+				}
+			}
+			return existing.UUID, nil
+		},
+	}, nil
+}
+
+func (p *planner) createServerRequest(exec commands.Executor, s serverManifest, title string, labels upcloud.LabelSlice) (*request.CreateServerRequest, error) {
+	req := &request.CreateServerRequest{
+		Hostname:         s.Hostname,
+		Title:            title,
+		Zone:             s.Zone,
+		Plan:             s.Plan,
+		UserData:         s.UserData,
+		Labels:           &labels,
+		PasswordDelivery: request.PasswordDeliveryNone,
+		LoginUser: &request.LoginUser{
+			CreatePassword: "no",
+			Username:       s.Username,
+		},
+	}
+
+	if len(s.SSHKeys) > 0 {
+		sshKeys, err := commands.ParseSSHKeys(s.SSHKeys)
+		if err != nil {
+			return nil, err
+		}
+		req.LoginUser.SSHKeys = sshKeys
+	}
+
+	if s.ServerGroup != "" {
+		uuid, err := p.uuid(typeServerGroup, s.ServerGroup)
+		if err != nil {
+			return nil, err
+		}
+		req.ServerGroup = uuid
+	}
+
+	osStorage, err := storage.SearchSingleStorage(s.OS, exec)
+	if err != nil {
+		return nil, err
+	}
+	// Enable metadata service for cloud-init templates.
+	if osStorage.TemplateType == upcloud.StorageTemplateTypeCloudInit {
+		req.Metadata = upcloud.True
+	}
+
+	size := s.OSStorageSize
+	if size == 0 {
+		plans, err := exec.All().GetPlans(exec.Context())
+		if err != nil {
+			return nil, err
+		}
+		for _, plan := range plans.Plans {
+			if plan.Name == s.Plan {
+				size = plan.StorageSize
+			}
+		}
+	}
+	req.StorageDevices = append(req.StorageDevices, request.CreateServerStorageDevice{
+		Action:  request.CreateServerStorageDeviceActionClone,
+		Address: "virtio",
+		Storage: osStorage.UUID,
+		Title:   osStorageTitle(s.Hostname),
+		Size:    size,
+		Type:    upcloud.StorageTypeDisk,
+	})
+
+	for _, strg := range s.Storages {
+		uuid, err := p.uuid(typeStorage, strg)
+		if err != nil {
+			return nil, err
+		}
+		req.StorageDevices = append(req.StorageDevices, request.CreateServerStorageDevice{
+			Action:  request.CreateServerStorageDeviceActionAttach,
+			Address: "virtio",
+			Storage: uuid,
+			Type:    upcloud.StorageTypeDisk,
+		})
+	}
+
+	if len(s.Networks) > 0 {
+		req.Networking = &request.CreateServerNetworking{}
+		for _, network := range s.Networks {
+			iface := request.CreateServerInterface{Type: network.Type}
+			if network.Network != "" {
+				uuid, err := p.uuid(typeNetwork, network.Network)
+				if err != nil {
+					return nil, err
+				}
+				iface.Network = uuid
+			}
+
+			family := network.Family
+			if family == "" {
+				family = upcloud.IPAddressFamilyIPv4
+			}
+			iface.IPAddresses = append(iface.IPAddresses, request.CreateServerIPAddress{
+				Family:  family,
+				Address: network.IPAddress,
+			})
+			req.Networking.Interfaces = append(req.Networking.Interfaces, iface)
+		}
+	}
+
+	return req, nil
+}
+
+// osStorageTitle returns the title of the OS storage created with the server.
+func osStorageTitle(hostname string) string {
+	return fmt.Sprintf("%s-OS", ui.TruncateText(hostname, 64-7))
+}
+
+// diffIPNetworks returns the changes needed to make the IP networks of an existing network match the manifest. The IP networks are matched by family. Gateway and DHCP DNS servers are only compared if they are defined in the manifest, as the API fills them otherwise. Adding, removing, and changing the address of IP networks is not supported.
+func diffIPNetworks(current, desired upcloud.IPNetworkSlice) ([]string, error) {
+	if len(current) != len(desired) {
+		return nil, fmt.Errorf("cannot change the number of IP networks from %d to %d, delete the network or rename it in the manifest", len(current), len(desired))
+	}
+
+	var details []string
+	for _, want := range desired {
+		i := slices.IndexFunc(current, func(n upcloud.IPNetwork) bool { return n.Family == want.Family })
+		if i < 0 {
+			return nil, fmt.Errorf("cannot add %s IP network %s, delete the network or rename it in the manifest", want.Family, want.Address)
+		}
+		have := current[i]
+		if !addressesEqual(have.Address, want.Address) {
+			return nil, fmt.Errorf("cannot change IP network address from %s to %s, delete the network or rename it in the manifest", have.Address, want.Address)
+		}
+
+		if have.DHCP.Bool() != want.DHCP.Bool() {
+			details = append(details, fmt.Sprintf("ip network %s dhcp: %t -> %t", want.Address, have.DHCP.Bool(), want.DHCP.Bool()))
+		}
+		if have.DHCPDefaultRoute.Bool() != want.DHCPDefaultRoute.Bool() {
+			details = append(details, fmt.Sprintf("ip network %s dhcp default route: %t -> %t", want.Address, have.DHCPDefaultRoute.Bool(), want.DHCPDefaultRoute.Bool()))
+		}
+		if want.Gateway != "" && have.Gateway != want.Gateway {
+			details = append(details, fmt.Sprintf("ip network %s gateway: %s -> %s", want.Address, have.Gateway, want.Gateway))
+		}
+		if len(want.DHCPDns) > 0 && !slices.Equal(have.DHCPDns, want.DHCPDns) {
+			details = append(details, fmt.Sprintf("ip network %s dhcp dns: %s -> %s", want.Address, strings.Join(have.DHCPDns, ", "), strings.Join(want.DHCPDns, ", ")))
+		}
+	}
+	return details, nil
+}
+
+// addressesEqual compares CIDR addresses ignoring the host bits, e.g. 10.0.0.1/24 equals 10.0.0.0/24.
+func addressesEqual(a, b string) bool {
+	prefixA, errA := netip.ParsePrefix(a)
+	prefixB, errB := netip.ParsePrefix(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return prefixA.Masked() == prefixB.Masked()
+}
+
+// firewallRulesEqual compares firewall rules ignoring their position field, as the order of the rules defines the position. Values the API fills with defaults are normalised before comparing.
+func firewallRulesEqual(current []upcloud.FirewallRule, desired request.FirewallRuleSlice) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	for i := range current {
+		if normaliseFirewallRule(current[i]) != normaliseFirewallRule(desired[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// normaliseFirewallRule fills the defaults the API uses for firewall rules: a range with only the start defined ends at the start, and ports and ICMP type are cleared for protocols that do not use them.
+func normaliseFirewallRule(rule upcloud.FirewallRule) upcloud.FirewallRule {
+	rule.Position = 0
+	rule.Action = strings.ToLower(rule.Action)
+	rule.Direction = strings.ToLower(rule.Direction)
+	rule.Protocol = strings.ToLower(rule.Protocol)
+	for _, r := range []struct{ start, end *string }{
+		{&rule.SourceAddressStart, &rule.SourceAddressEnd},
+		{&rule.SourcePortStart, &rule.SourcePortEnd},
+		{&rule.DestinationAddressStart, &rule.DestinationAddressEnd},
+		{&rule.DestinationPortStart, &rule.DestinationPortEnd},
+	} {
+		if *r.end == "" {
+			*r.end = *r.start
+		}
+	}
+	// Ports and ICMP types are only used with the matching protocols.
+	if rule.Protocol != upcloud.FirewallRuleProtocolTCP && rule.Protocol != upcloud.FirewallRuleProtocolUDP {
+		rule.SourcePortStart, rule.SourcePortEnd, rule.DestinationPortStart, rule.DestinationPortEnd = "", "", "", ""
+	}
+	if rule.Protocol != upcloud.FirewallRuleProtocolICMP {
+		rule.ICMPType = ""
+	}
+	return rule
+}
+
+func (p *planner) planPrune(exec commands.Executor) ([]*change, error) {
+	var changes []*change
+	name := p.manifest.Name
+
+	storages, err := findManaged(&p.storages, p.resolvers[typeStorage], name, p.manifest.storageNames(),
+		func(v upcloud.Storage) []upcloud.Label { return v.Labels },
+		func(v upcloud.Storage) string { return v.Title })
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers are listed without labels, so server details are needed to find the managed servers.
+	resolved := p.resolvers[typeServer]("*")
+	serverUUIDs, err := resolved.GetAll()
+	if err != nil && !errors.Is(err, resolver.NotFoundError("*")) {
+		return nil, err
+	}
+	for _, uuid := range serverUUIDs {
+		server, err := exec.Server().GetServerDetails(exec.Context(), &request.GetServerDetailsRequest{UUID: uuid})
+		if err != nil {
+			return nil, err
+		}
+		if !isManagedBy(server.Labels, name) || slices.Contains(p.manifest.serverNames(), server.Hostname) {
+			continue
+		}
+
+		// Delete the OS storage created with the server, unless it is defined in the manifest or deleted as a managed storage. Other attached storages are only detached.
+		var osStorages []upcloud.ServerStorageDevice
+		for _, device := range server.StorageDevices {
+			if device.Title != osStorageTitle(server.Hostname) || slices.Contains(p.manifest.storageNames(), device.Title) {
+				continue
+			}
+			if slices.ContainsFunc(storages, func(strg upcloud.Storage) bool { return strg.UUID == device.UUID }) {
+				continue
+			}
+			osStorages = append(osStorages, device)
+		}
+		changes = append(changes, deleteServerChange(server, osStorages))
+	}
+	slices.SortFunc(changes, func(a, b *change) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, strg := range storages {
+		changes = append(changes, deleteChange(typeStorage, strg.Title, strg.UUID, func(exec commands.Executor) error {
+			return exec.Storage().DeleteStorage(exec.Context(), &request.DeleteStorageRequest{UUID: strg.UUID})
+		}))
+	}
+
+	serverGroups, err := findManaged(&p.serverGroups, p.resolvers[typeServerGroup], name, p.manifest.serverGroupNames(),
+		func(v upcloud.ServerGroup) []upcloud.Label { return v.Labels },
+		func(v upcloud.ServerGroup) string { return v.Title })
+	if err != nil {
+		return nil, err
+	}
+	for _, serverGroup := range serverGroups {
+		changes = append(changes, deleteChange(typeServerGroup, serverGroup.Title, serverGroup.UUID, func(exec commands.Executor) error {
+			return exec.All().DeleteServerGroup(exec.Context(), &request.DeleteServerGroupRequest{UUID: serverGroup.UUID})
+		}))
+	}
+
+	networks, err := findManaged(&p.networks, p.resolvers[typeNetwork], name, p.manifest.networkNames(),
+		func(v upcloud.Network) []upcloud.Label { return v.Labels },
+		func(v upcloud.Network) string { return v.Name })
+	if err != nil {
+		return nil, err
+	}
+	for _, network := range networks {
+		changes = append(changes, deleteChange(typeNetwork, network.Name, network.UUID, func(exec commands.Executor) error {
+			return exec.Network().DeleteNetwork(exec.Context(), &request.DeleteNetworkRequest{UUID: network.UUID})
+		}))
+	}
+
+	routers, err := findManaged(&p.routers, p.resolvers[typeRouter], name, p.manifest.routerNames(),
+		func(v upcloud.Router) []upcloud.Label { return v.Labels },
+		func(v upcloud.Router) string { return v.Name })
+	if err != nil {
+		return nil, err
+	}
+	for _, router := range routers {
+		changes = append(changes, deleteChange(typeRouter, router.Name, router.UUID, func(exec commands.Executor) error {
+			return exec.Network().DeleteRouter(exec.Context(), &request.DeleteRouterRequest{UUID: router.UUID})
+		}))
+	}
+
+	return changes, nil
+}
+
+func deleteChange(typ, name, uuid string, del func(exec commands.Executor) error) *change {
+	return &change{
+		Action: actionDelete,
+		Type:   typ,
+		Name:   name,
+		UUID:   uuid,
+		apply: func(exec commands.Executor) (string, error) {
+			return uuid, del(exec)
+		},
+	}
+}
+
+// deleteServerChange deletes the server and the given storages. Storages are deleted one by one, so that storages not owned by the manifest are kept.
+func deleteServerChange(server *upcloud.ServerDetails, storages []upcloud.ServerStorageDevice) *change {
+	var details []string
+	for _, strg := range storages {
+		details = append(details, fmt.Sprintf("delete storage %s", strg.Title))
+	}
+
+	return &change{
+		Action:  actionDelete,
+		Type:    typeServer,
+		Name:    server.Hostname,
+		UUID:    server.UUID,
+		Details: details,
+		apply: func(exec commands.Executor) (string, error) {
+			svc := exec.Server()
+			if server.State != upcloud.ServerStateStopped {
+				if _, err := svc.StopServer(exec.Context(), &request.StopServerRequest{
+					UUID:     server.UUID,
+					StopType: request.ServerStopTypeHard,
+				}); err != nil {
+					return "", err
+				}
+
+				ctx, cancel := context.WithTimeout(exec.Context(), waitTimeout)
+				defer cancel()
+				if _, err := svc.WaitForServerState(ctx, &request.WaitForServerStateRequest{
+					UUID:         server.UUID,
+					DesiredState: upcloud.ServerStateStopped,
+				}); err != nil {
+					return "", err
+				}
+			}
+
+			if err := svc.DeleteServer(exec.Context(), &request.DeleteServerRequest{UUID: server.UUID}); err != nil {
+				return "", err
+			}
+			for _, strg := range storages {
+				if err := exec.Storage().DeleteStorage(exec.Context(), &request.DeleteStorageRequest{UUID: strg.UUID}); err != nil {
+					return "", err
+				}
+			}
+			return server.UUID, nil
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirewallRulesEqual(t *testing.T) {
+	desired := request.FirewallRuleSlice{
+		{Direction: "in", Action: "accept", Family: "IPv4", Protocol: "tcp", DestinationPortStart: "22"},
+		{Direction: "in", Action: "accept", Family: "IPv4", Protocol: "icmp", ICMPType: "8", SourceAddressStart: "10.0.0.1"},
+		{Direction: "in", Action: "drop"},
+	}
+
+	for _, test := range []struct {
+		name    string
+		current []upcloud.FirewallRule
+		equal   bool
+	}{
+		{
+			name: "defaults filled by the API",
+			current: []upcloud.FirewallRule{
+				{Position: 1, Direction: "in", Action: "accept", Family: "IPv4", Protocol: "tcp", DestinationPortStart: "22", DestinationPortEnd: "22"},
+				{Position: 2, Direction: "in", Action: "accept", Family: "IPv4", Protocol: "icmp", ICMPType: "8", SourceAddressStart: "10.0.0.1", SourceAddressEnd: "10.0.0.1"},
+				{Position: 3, Direction: "in", Action: "drop"},
+			},
+			equal: true,
+		},
+		{
+			name: "changed port",
+			current: []upcloud.FirewallRule{
+				{Position: 1, Direction: "in", Action: "accept", Family: "IPv4", Protocol: "tcp", DestinationPortStart: "22", DestinationPortEnd: "23"},
+				{Position: 2, Direction: "in", Action: "accept", Family: "IPv4", Protocol: "icmp", ICMPType: "8", SourceAddressStart: "10.0.0.1", SourceAddressEnd: "10.0.0.1"},
+				{Position: 3, Direction: "in", Action: "drop"},
+			},
+			equal: false,
+		},
+		{
+			name: "changed order",
+			current: []upcloud.FirewallRule{
+				{Position: 1, Direction: "in", Action: "drop"},
+				{Position: 2, Direction: "in", Action: "accept", Family: "IPv4", Protocol: "tcp", DestinationPortStart: "22", DestinationPortEnd: "22"},
+				{Position: 3, Direction: "in", Action: "accept", Family: "IPv4", Protocol: "icmp", ICMPType: "8", SourceAddressStart: "10.0.0.1", SourceAddressEnd: "10.0.0.1"},
+			},
+			equal: false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.equal, firewallRulesEqual(test.current, desired))
+		})
+	}
+}
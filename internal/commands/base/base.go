@@ -4,19 +4,35 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/account"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/account/permissions"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/account/profile"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/account/token"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/all"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/apply"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/auditlog"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database"
+	databaseaccesscontrol "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/accesscontrol"
 	databaseindex "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/index"
+	databaseipfilter "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/ipfilter"
 	databaseproperties "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/properties"
 	databasesession "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/session"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	gatewayconnection "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection"
+	gatewayconnectiontunnel "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection/tunnel"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/host"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/ipaddress"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/kubernetes"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/kubernetes/nodegroup"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	loadbalancerbackend "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/backend"
+	loadbalancerbackendmember "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/backend/member"
+	loadbalancerbackendtlsconfig "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/backend/tlsconfig"
+	loadbalancercertificatebundle "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/certificatebundle"
+	loadbalancerfrontend "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/frontend"
+	loadbalancerfrontendrule "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/frontend/rule"
+	loadbalancerfrontendtlsconfig "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/frontend/tlsconfig"
+	loadbalanceripaddress "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/ipaddress"
+	loadbalancernetwork "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/network"
+	loadbalancerresolver "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/resolver"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/network"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/networkpeering"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage"
@@ -145,6 +161,12 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(token.ShowCommand(), tokenCommand.Cobra(), conf)
 	commands.BuildCommand(token.DeleteCommand(), tokenCommand.Cobra(), conf)
 
+	// Account profile
+	profileCommand := commands.BuildCommand(profile.BaseProfileCommand(), accountCommand.Cobra(), conf)
+	commands.BuildCommand(profile.ListCommand(), profileCommand.Cobra(), conf)
+	commands.BuildCommand(profile.ShowCommand(), profileCommand.Cobra(), conf)
+	commands.BuildCommand(profile.UseCommand(), profileCommand.Cobra(), conf)
+
 	// Zone
 	zoneCommand := commands.BuildCommand(zone.BaseZoneCommand(), rootCmd, conf)
 	commands.BuildCommand(zone.ListCommand(), zoneCommand.Cobra(), conf)
@@ -154,8 +176,14 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	// Databases
 	databaseCommand := commands.BuildCommand(database.BaseDatabaseCommand(), rootCmd, conf)
 	commands.BuildCommand(database.CreateCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.ModifyCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.ListCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.ShowCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.LogsCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.MetricsCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.QueryStatsCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.VersionsCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.UpgradeCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.TypesCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.PlansCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.StartCommand(), databaseCommand.Cobra(), conf)
@@ -187,13 +215,98 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(databaseindex.DeleteCommand(), indexCommand.Cobra(), conf)
 	commands.BuildCommand(databaseindex.ListCommand(), indexCommand.Cobra(), conf)
 
+	// Database access control
+	accessControlCommand := commands.BuildCommand(databaseaccesscontrol.BaseAccessControlCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(databaseaccesscontrol.ShowCommand(), accessControlCommand.Cobra(), conf)
+	commands.BuildCommand(databaseaccesscontrol.ModifyCommand(), accessControlCommand.Cobra(), conf)
+
+	// Database IP filter
+	ipFilterCommand := commands.BuildCommand(databaseipfilter.BaseIPFilterCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(databaseipfilter.ListCommand(), ipFilterCommand.Cobra(), conf)
+	commands.BuildCommand(databaseipfilter.AddCommand(), ipFilterCommand.Cobra(), conf)
+	commands.BuildCommand(databaseipfilter.RemoveCommand(), ipFilterCommand.Cobra(), conf)
+
 	// LoadBalancers
 	loadbalancerCommand := commands.BuildCommand(loadbalancer.BaseLoadBalancerCommand(), rootCmd, conf)
 	commands.BuildCommand(loadbalancer.ListCommand(), loadbalancerCommand.Cobra(), conf)
 	commands.BuildCommand(loadbalancer.ShowCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancer.CreateCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancer.ModifyCommand(), loadbalancerCommand.Cobra(), conf)
 	commands.BuildCommand(loadbalancer.DeleteCommand(), loadbalancerCommand.Cobra(), conf)
 	commands.BuildCommand(loadbalancer.PlansCommand(), loadbalancerCommand.Cobra(), conf)
 
+	// LoadBalancer frontends
+	frontendCommand := commands.BuildCommand(loadbalancerfrontend.BaseFrontendCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.ListCommand(), frontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.ShowCommand(), frontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.CreateCommand(), frontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.ModifyCommand(), frontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.DeleteCommand(), frontendCommand.Cobra(), conf)
+
+	// LoadBalancer frontend rules
+	frontendRuleCommand := commands.BuildCommand(loadbalancerfrontendrule.BaseRuleCommand(), frontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendrule.ListCommand(), frontendRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendrule.CreateCommand(), frontendRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendrule.ModifyCommand(), frontendRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendrule.ReplaceCommand(), frontendRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendrule.DeleteCommand(), frontendRuleCommand.Cobra(), conf)
+
+	// LoadBalancer frontend TLS configs
+	frontendTLSConfigCommand := commands.BuildCommand(loadbalancerfrontendtlsconfig.BaseTLSConfigCommand(), frontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendtlsconfig.ListCommand(), frontendTLSConfigCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendtlsconfig.CreateCommand(), frontendTLSConfigCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendtlsconfig.ModifyCommand(), frontendTLSConfigCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontendtlsconfig.DeleteCommand(), frontendTLSConfigCommand.Cobra(), conf)
+
+	// LoadBalancer backends
+	backendCommand := commands.BuildCommand(loadbalancerbackend.BaseBackendCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.ListCommand(), backendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.ShowCommand(), backendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.CreateCommand(), backendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.ModifyCommand(), backendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.DeleteCommand(), backendCommand.Cobra(), conf)
+
+	// LoadBalancer backend members
+	backendMemberCommand := commands.BuildCommand(loadbalancerbackendmember.BaseMemberCommand(), backendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendmember.AddCommand(), backendMemberCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendmember.ModifyCommand(), backendMemberCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendmember.RemoveCommand(), backendMemberCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendmember.EnableCommand(), backendMemberCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendmember.DisableCommand(), backendMemberCommand.Cobra(), conf)
+
+	// LoadBalancer backend TLS configs
+	backendTLSConfigCommand := commands.BuildCommand(loadbalancerbackendtlsconfig.BaseTLSConfigCommand(), backendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendtlsconfig.ListCommand(), backendTLSConfigCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendtlsconfig.CreateCommand(), backendTLSConfigCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendtlsconfig.ModifyCommand(), backendTLSConfigCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackendtlsconfig.DeleteCommand(), backendTLSConfigCommand.Cobra(), conf)
+
+	// LoadBalancer certificate bundles
+	certificateBundleCommand := commands.BuildCommand(loadbalancercertificatebundle.BaseCertificateBundleCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.ListCommand(), certificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.ShowCommand(), certificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.CreateCommand(), certificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.ModifyCommand(), certificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.DeleteCommand(), certificateBundleCommand.Cobra(), conf)
+
+	// LoadBalancer resolvers
+	resolverCommand := commands.BuildCommand(loadbalancerresolver.BaseResolverCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerresolver.ListCommand(), resolverCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerresolver.CreateCommand(), resolverCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerresolver.ModifyCommand(), resolverCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerresolver.DeleteCommand(), resolverCommand.Cobra(), conf)
+
+	// LoadBalancer networks
+	lbNetworkCommand := commands.BuildCommand(loadbalancernetwork.BaseNetworkCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancernetwork.ListCommand(), lbNetworkCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancernetwork.ModifyCommand(), lbNetworkCommand.Cobra(), conf)
+
+	// LoadBalancer floating IP addresses
+	lbIPAddressCommand := commands.BuildCommand(loadbalanceripaddress.BaseIPAddressCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalanceripaddress.ListCommand(), lbIPAddressCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalanceripaddress.AttachCommand(), lbIPAddressCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalanceripaddress.RemoveCommand(), lbIPAddressCommand.Cobra(), conf)
+
 	// Kubernetes
 	kubernetesCommand := commands.BuildCommand(kubernetes.BaseKubernetesCommand(), rootCmd, conf)
 	commands.BuildCommand(kubernetes.CreateCommand(), kubernetesCommand.Cobra(), conf)
@@ -260,9 +373,28 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 
 	// Network Gateway operations
 	gatewayCommand := commands.BuildCommand(gateway.BaseGatewayCommand(), rootCmd, conf)
+	commands.BuildCommand(gateway.CreateCommand(), gatewayCommand.Cobra(), conf)
 	commands.BuildCommand(gateway.DeleteCommand(), gatewayCommand.Cobra(), conf)
 	commands.BuildCommand(gateway.ListCommand(), gatewayCommand.Cobra(), conf)
+	commands.BuildCommand(gateway.MetricsCommand(), gatewayCommand.Cobra(), conf)
+	commands.BuildCommand(gateway.ModifyCommand(), gatewayCommand.Cobra(), conf)
 	commands.BuildCommand(gateway.PlansCommand(), gatewayCommand.Cobra(), conf)
+	commands.BuildCommand(gateway.ShowCommand(), gatewayCommand.Cobra(), conf)
+
+	// Network Gateway connections
+	gatewayConnectionCommand := commands.BuildCommand(gatewayconnection.BaseConnectionCommand(), gatewayCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.ListCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.ShowCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.CreateCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.ModifyCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.DeleteCommand(), gatewayConnectionCommand.Cobra(), conf)
+
+	// Network Gateway connection tunnels
+	gatewayTunnelCommand := commands.BuildCommand(gatewayconnectiontunnel.BaseTunnelCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnectiontunnel.ListCommand(), gatewayTunnelCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnectiontunnel.ShowCommand(), gatewayTunnelCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnectiontunnel.CreateCommand(), gatewayTunnelCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnectiontunnel.DeleteCommand(), gatewayTunnelCommand.Cobra(), conf)
 
 	// Host operations
 	hostCommand := commands.BuildCommand(host.BaseHostCommand(), rootCmd, conf)
@@ -282,6 +414,10 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	allCommand := commands.BuildCommand(all.BaseAllCommand(), rootCmd, conf)
 	commands.BuildCommand(all.PurgeCommand(), allCommand.Cobra(), conf)
 	commands.BuildCommand(all.ListCommand(), allCommand.Cobra(), conf)
+
+	// Declarative resource management
+	commands.BuildCommand(apply.ApplyCommand(), rootCmd, conf)
+
 	// Stack operations
 	stackCommand := commands.BuildCommand(stack.BaseStackCommand(), rootCmd, conf)
 	stackDeployCommand := commands.BuildCommand(stack.DeployCommand(), stackCommand.Cobra(), conf)
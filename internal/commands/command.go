@@ -85,18 +85,15 @@ func BuildCommand(child Command, parent *cobra.Command, config *config.Config) C
 		parent.AddCommand(child.Cobra())
 	}
 
-	// XXX: Maybe put back the viper default flags value to child commands
-	// params?  It is was implemented back in
-	// 5ece0e1b31df5d542546d81bbf2472c2e97aadff
-	// How does it work:
-	// A common viper instance can be shared for all commands, each flags has
-	// the format Name:
-	// Parent.Child1...Childn.FlagName
-
 	// Init
 	child.InitCommand()
 	child.InitCommandWithConfig(config)
 
+	// Destructive commands can require typing the name of the resource to confirm the operation
+	if _, ok := child.(DestructiveCommand); ok {
+		child.Cobra().Flags().Bool(FlagConfirmName, false, "Require typing the name of the resource to confirm the operation instead of answering yes, even if `--yes` is given. Can only be used with a single resource.")
+	}
+
 	// Set up completion, if necessary
 	if cp, ok := child.(completion.Provider); ok {
 		child.Cobra().ValidArgsFunction = func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -107,7 +104,7 @@ func BuildCommand(child Command, parent *cobra.Command, config *config.Config) C
 			}
 			return cp.CompleteArgument(config.Context(), svc, toComplete)
 		}
-	} else {
+	} else if child.Cobra().ValidArgsFunction == nil {
 		// Otherwise offer no completions.
 		// This, rather than file completions, is the common case for our commands.
 		child.Cobra().ValidArgsFunction = cobra.NoFileCompletions
@@ -119,8 +116,27 @@ func BuildCommand(child Command, parent *cobra.Command, config *config.Config) C
 		child.Cobra().Use = child.Cobra().Name()
 	}
 
+	// Show defaults defined in config in the help output. Config is not loaded when help is requested, so load it here.
+	helpFunc := child.Cobra().HelpFunc()
+	child.Cobra().SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		if cmd == child.Cobra() {
+			if err := config.Load(); err == nil {
+				setHelpDefaultsFromConfig(cmd, config)
+			}
+		}
+		helpFunc(cmd, args)
+	})
+
+	// Use defaults from config, e.g., server.create.zone, for flags not given on the command line.
+	// This is done in PreRunE as it is run after the config has been loaded but before required flags are validated.
+	child.Cobra().PreRunE = func(cmd *cobra.Command, _ []string) error {
+		return setFlagDefaultsFromConfig(cmd, config)
+	}
+
 	// Set run
-	child.Cobra().RunE = func(_ *cobra.Command, args []string) error {
+	child.Cobra().RunE = func(cmd *cobra.Command, args []string) error {
+		MarkConfigDefaultsChanged(cmd)
+
 		// Do not create service for offline commands, e.g. upctl version
 		if _, ok := child.(OfflineCommand); ok {
 			return commandRunE(child, nil, config, args)
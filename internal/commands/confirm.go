@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/terminal"
+)
+
+// FlagConfirmName is the name of the flag that requires typing the name of the resource to confirm a destructive operation.
+const FlagConfirmName = "confirm-name"
+
+// isInteractive is used to check if the user can be prompted for confirmation. It is a variable to allow overriding it in tests.
+var isInteractive = terminal.IsStdinTerminal
+
+// errNotConfirmed is returned when the user declines the confirmation prompt.
+var errNotConfirmed = errors.New("operation was not confirmed")
+
+// errConfirmationRequired is returned when a destructive operation needs to be confirmed, but the user can not be prompted.
+var errConfirmationRequired = errors.New("confirming the operation requires an interactive terminal. Use --yes flag to confirm the operation in non-interactive mode")
+
+// errConfirmNameRequiresTerminal is returned when `--confirm-name` is used, but the user can not be prompted.
+var errConfirmNameRequiresTerminal = fmt.Errorf("--%s requires an interactive terminal for typing the name of the resource", FlagConfirmName)
+
+// DestructiveCommand is a command that deletes the resources given as positional arguments. The resolved resources are listed and the user must confirm the operation before the command is executed.
+type DestructiveCommand interface {
+	MultipleArgumentCommand
+	// ResourceName returns the name of the resource with the given UUID. The name is shown in the confirmation prompt.
+	ResourceName(uuid string) string
+}
+
+// ConfirmResource is a resource listed in the confirmation prompt of a destructive operation.
+type ConfirmResource struct {
+	Type string
+	UUID string
+	Name string
+}
+
+func (r ConfirmResource) String() string {
+	var s string
+	switch {
+	case r.Name == "":
+		s = r.UUID
+	case r.UUID == "":
+		s = r.Name
+	default:
+		s = fmt.Sprintf("%s (%s)", r.Name, r.UUID)
+	}
+
+	if r.Type != "" {
+		s = r.Type + " " + s
+	}
+	return s
+}
+
+// ConfirmDeletion lists the given resources and asks the user to confirm deleting them. Confirmation is not needed with `--yes` flag or in dry-run mode. If the command has `--confirm-name` flag set, the user must type the name of the resource instead of answering yes, even if `--yes` flag is set.
+func ConfirmDeletion(cmd Command, cfg *config.Config, resources []ConfirmResource) error {
+	return ConfirmOperation(cmd, cfg, "deleted", "Delete", resources)
+}
+
+// ConfirmOperation works like ConfirmDeletion for operations other than deletion. The resources are listed as resources that will be `result` and the user is asked to `action` them, e.g. "upgraded" and "Upgrade".
+func ConfirmOperation(cmd Command, cfg *config.Config, result, action string, resources []ConfirmResource) error {
+	confirmName := false
+	if flag := cmd.Cobra().Flags().Lookup(FlagConfirmName); flag != nil {
+		confirmName = flag.Value.String() == "true"
+	}
+	if confirmName && len(resources) > 1 {
+		return fmt.Errorf("--%s can only be used when deleting a single resource, %d resources were given", FlagConfirmName, len(resources))
+	}
+
+	// `--yes` does not override `--confirm-name`, the name must always be typed.
+	if len(resources) == 0 || (cfg.AssumeYes() && !confirmName) || cfg.DryRun() {
+		return nil
+	}
+	if !isInteractive() {
+		if confirmName {
+			return errConfirmNameRequiresTerminal
+		}
+		return errConfirmationRequired
+	}
+
+	w := cmd.Cobra().ErrOrStderr()
+	fmt.Fprintf(w, "The following %d resource(s) will be %s:\n", len(resources), result)
+	for _, resource := range resources {
+		fmt.Fprintf(w, "  %s\n", resource)
+	}
+
+	var confirmed bool
+	var err error
+	if confirmName {
+		name := resources[0].Name
+		if name == "" {
+			name = resources[0].UUID
+		}
+		confirmed, err = promptName(cmd.Cobra().InOrStdin(), w, name)
+	} else {
+		confirmed, err = promptConfirmation(cmd.Cobra().InOrStdin(), w, fmt.Sprintf("%s %d resource(s)?", action, len(resources)))
+	}
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errNotConfirmed
+	}
+	return nil
+}
+
+// confirmDestructive asks the user to confirm the operation of a destructive command for the successfully resolved arguments.
+func confirmDestructive(cmd DestructiveCommand, cfg *config.Config, args []resolvedArgument) error {
+	resources := make([]ConfirmResource, 0, len(args))
+	for _, arg := range args {
+		if arg.Error != nil {
+			continue
+		}
+		resources = append(resources, ConfirmResource{UUID: arg.Resolved, Name: cmd.ResourceName(arg.Resolved)})
+	}
+	return ConfirmDeletion(cmd, cfg, resources)
+}
+
+// promptConfirmation writes the prompt to w and reads the answer from r. Only `y` and `yes` answers are considered as confirmation.
+func promptConfirmation(r io.Reader, w io.Writer, prompt string) (bool, error) {
+	fmt.Fprintf(w, "%s [y/N]: ", prompt)
+
+	answer, err := readAnswer(r)
+	if err != nil {
+		return false, err
+	}
+
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// promptName writes a prompt asking to type the given name to w and reads the answer from r. Only the exact name is considered as confirmation.
+func promptName(r io.Reader, w io.Writer, name string) (bool, error) {
+	fmt.Fprintf(w, "Type the name of the resource (%s) to confirm: ", name)
+
+	answer, err := readAnswer(r)
+	if err != nil {
+		return false, err
+	}
+	return answer == name, nil
+}
+
+func readAnswer(r io.Reader) (string, error) {
+	answer, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	return strings.TrimSpace(answer), nil
+}
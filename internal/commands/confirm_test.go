@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDestructive is a destructive command that names resources by removing the `uuid:` prefix of the resolved UUIDs.
+type mockDestructive struct {
+	*mockMultiResolver
+}
+
+func (m *mockDestructive) ResourceName(uuid string) string {
+	return strings.TrimPrefix(uuid, "uuid:")
+}
+
+func TestConfirmDeletion(t *testing.T) {
+	resources := []ConfirmResource{{Type: "server", UUID: "uuid-1", Name: "web-1"}}
+	for _, test := range []struct {
+		name           string
+		resources      []ConfirmResource
+		yes            bool
+		dryRun         bool
+		confirmName    bool
+		interactive    bool
+		input          string
+		expectedErr    string
+		expectedPrompt string
+	}{
+		{
+			name:      "confirmed with --yes",
+			resources: resources,
+			yes:       true,
+		},
+		{
+			name:      "dry-run",
+			resources: resources,
+			dryRun:    true,
+		},
+		{
+			name:        "non-interactive",
+			resources:   resources,
+			expectedErr: "confirming the operation requires an interactive terminal. Use --yes flag to confirm the operation in non-interactive mode",
+		},
+		{
+			name:           "confirmed",
+			resources:      resources,
+			interactive:    true,
+			input:          "y\n",
+			expectedPrompt: "The following 1 resource(s) will be deleted:\n  server web-1 (uuid-1)\nDelete 1 resource(s)? [y/N]: ",
+		},
+		{
+			name:        "not confirmed",
+			resources:   resources,
+			interactive: true,
+			input:       "\n",
+			expectedErr: "operation was not confirmed",
+		},
+		{
+			name:           "confirmed with name",
+			resources:      resources,
+			confirmName:    true,
+			interactive:    true,
+			input:          "web-1\n",
+			expectedPrompt: "Type the name of the resource (web-1) to confirm: ",
+		},
+		{
+			name:        "wrong name",
+			resources:   resources,
+			confirmName: true,
+			interactive: true,
+			input:       "yes\n",
+			expectedErr: "operation was not confirmed",
+		},
+		{
+			name:        "wrong name with yes",
+			resources:   resources,
+			confirmName: true,
+			yes:         true,
+			interactive: true,
+			input:       "web-2\n",
+			expectedErr: "operation was not confirmed",
+		},
+		{
+			name:        "confirm name in non-interactive mode with yes",
+			resources:   resources,
+			confirmName: true,
+			yes:         true,
+			expectedErr: "--confirm-name requires an interactive terminal for typing the name of the resource",
+		},
+		{
+			name:        "confirm name with multiple resources",
+			resources:   append(resources, ConfirmResource{UUID: "uuid-2"}),
+			confirmName: true,
+			yes:         true,
+			expectedErr: "--confirm-name can only be used when deleting a single resource, 2 resources were given",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			origIsInteractive := isInteractive
+			isInteractive = func() bool { return test.interactive }
+			defer func() { isInteractive = origIsInteractive }()
+
+			cmd := &mockDestructive{mockMultiResolver: &mockMultiResolver{Command: &cobra.Command{}}}
+			cmd.Cobra().Flags().Bool(FlagConfirmName, test.confirmName, "")
+			cmd.Cobra().SetIn(strings.NewReader(test.input))
+			stderr := new(bytes.Buffer)
+			cmd.Cobra().SetErr(stderr)
+
+			cfg := config.New()
+			cfg.Viper().Set(config.KeyYes, test.yes)
+			cfg.Viper().Set(config.KeyDryRun, test.dryRun)
+
+			err := ConfirmDeletion(cmd, cfg, test.resources)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, stderr.String(), test.expectedPrompt)
+		})
+	}
+}
+
+func TestConfirmOperation(t *testing.T) {
+	origIsInteractive := isInteractive
+	isInteractive = func() bool { return true }
+	defer func() { isInteractive = origIsInteractive }()
+
+	cmd := &mockDestructive{mockMultiResolver: &mockMultiResolver{Command: &cobra.Command{}}}
+	cmd.Cobra().SetIn(strings.NewReader("y\n"))
+	stderr := new(bytes.Buffer)
+	cmd.Cobra().SetErr(stderr)
+
+	err := ConfirmOperation(cmd, config.New(), "upgraded", "Upgrade", []ConfirmResource{{Type: "database", UUID: "uuid-1", Name: "db-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "The following 1 resource(s) will be upgraded:\n  database db-1 (uuid-1)\nUpgrade 1 resource(s)? [y/N]: ", stderr.String())
+}
+
+func TestRunCommand_Destructive(t *testing.T) {
+	origIsInteractive := isInteractive
+	isInteractive = func() bool { return true }
+	defer func() { isInteractive = origIsInteractive }()
+
+	cmd := &mockDestructive{mockMultiResolver: &mockMultiResolver{Command: &cobra.Command{}}}
+	cmd.On("Execute", mock.Anything, mock.Anything).Return(output.None{}, nil)
+	cmd.Cobra().SetIn(strings.NewReader("n\n"))
+	stderr := new(bytes.Buffer)
+	cmd.Cobra().SetErr(stderr)
+
+	cfg := config.New()
+	err := commandRunE(cmd, &smock.Service{}, cfg, []string{"a", "b", "failtoresolve"})
+	assert.EqualError(t, err, "operation was not confirmed")
+	assert.Contains(t, stderr.String(), "  a (uuid:a)\n  b (uuid:b)\nDelete 2 resource(s)?")
+	cmd.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,34 @@
+package databaseaccesscontrol
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// BaseAccessControlCommand creates the base "database access-control" command
+func BaseAccessControlCommand() commands.Command {
+	return &accessControlCommand{
+		commands.New("access-control", "Manage access control settings of OpenSearch databases"),
+	}
+}
+
+type accessControlCommand struct {
+	*commands.BaseCommand
+}
+
+// requireOpenSearch returns an error if the database is not an OpenSearch database, as access control settings are not available for other database types.
+func requireOpenSearch(exec commands.Executor, uuid string) error {
+	db, err := exec.All().GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return err
+	}
+
+	if db.Type != upcloud.ManagedDatabaseServiceTypeOpenSearch {
+		return fmt.Errorf("access control not supported for database type %s", db.Type)
+	}
+	return nil
+}
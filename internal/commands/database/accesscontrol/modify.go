@@ -0,0 +1,74 @@
+package databaseaccesscontrol
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "database access-control modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify access control settings of an OpenSearch database",
+			"upctl database access-control modify my-opensearch-database --enable-access-control",
+			"upctl database access-control modify my-opensearch-database --enable-access-control --disable-extended-access-control",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+
+	accessControl         config.OptionalBoolean
+	extendedAccessControl config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	config.AddEnableDisableFlags(fs, &c.accessControl, "access-control", "access control to restrict the permissions of users with access control rules")
+	config.AddEnableDisableFlags(fs, &c.extendedAccessControl, "extended-access-control", "extended access control to also apply the rules to the aliases and patterns of the indices")
+	c.AddFlags(fs)
+
+	c.Cobra().MarkFlagsOneRequired("enable-access-control", "disable-access-control", "enable-extended-access-control", "disable-extended-access-control")
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	if err := requireOpenSearch(exec, uuid); err != nil {
+		return nil, err
+	}
+
+	req := request.ModifyManagedDatabaseAccessControlRequest{ServiceUUID: uuid}
+	if c.accessControl.IsSet() {
+		accessControl := c.accessControl.Value()
+		req.ACLsEnabled = &accessControl
+	}
+	if c.extendedAccessControl.IsSet() {
+		extendedAccessControl := c.extendedAccessControl.Value()
+		req.ExtendedACLsEnabled = &extendedAccessControl
+	}
+
+	msg := fmt.Sprintf("Modifying access control settings of database %s", uuid)
+	exec.PushProgressStarted(msg)
+
+	acl, err := exec.All().ModifyManagedDatabaseAccessControl(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: acl}, nil
+}
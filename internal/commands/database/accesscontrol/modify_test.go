@@ -0,0 +1,69 @@
+package databaseaccesscontrol
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestModifyCommand(t *testing.T) {
+	enabled, disabled := true, false
+	uuid := "0927dfd6-3884-4079-a948-3a8881df1a7a"
+
+	for _, test := range []struct {
+		name   string
+		args   []string
+		dbType upcloud.ManagedDatabaseServiceType
+		req    request.ModifyManagedDatabaseAccessControlRequest
+		error  string
+	}{
+		{
+			name:   "enable access control",
+			args:   []string{uuid, "--enable-access-control"},
+			dbType: upcloud.ManagedDatabaseServiceTypeOpenSearch,
+			req:    request.ModifyManagedDatabaseAccessControlRequest{ServiceUUID: uuid, ACLsEnabled: &enabled},
+		},
+		{
+			name:   "enable access control and disable extended access control",
+			args:   []string{uuid, "--enable-access-control", "--disable-extended-access-control"},
+			dbType: upcloud.ManagedDatabaseServiceTypeOpenSearch,
+			req:    request.ModifyManagedDatabaseAccessControlRequest{ServiceUUID: uuid, ACLsEnabled: &enabled, ExtendedACLsEnabled: &disabled},
+		},
+		{
+			name:   "unsupported database type",
+			args:   []string{uuid, "--disable-access-control"},
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			error:  "access control not supported for database type pg",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := smock.Service{}
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: uuid}).Return(&upcloud.ManagedDatabase{UUID: uuid, Type: test.dbType}, nil)
+			mService.On("ModifyManagedDatabaseAccessControl", &test.req).Return(&upcloud.ManagedDatabaseAccessControl{
+				ACLsEnabled:         test.req.ACLsEnabled,
+				ExtendedACLsEnabled: test.req.ExtendedACLsEnabled,
+			}, nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, "ModifyManagedDatabaseAccessControl", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			mService.AssertNumberOfCalls(t, "ModifyManagedDatabaseAccessControl", 1)
+		})
+	}
+}
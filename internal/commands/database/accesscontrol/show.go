@@ -0,0 +1,55 @@
+package databaseaccesscontrol
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ShowCommand creates the "database access-control show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show access control settings of an OpenSearch database",
+			"upctl database access-control show my-opensearch-database",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	if err := requireOpenSearch(exec, uuid); err != nil {
+		return nil, err
+	}
+
+	acl, err := exec.All().GetManagedDatabaseAccessControl(exec.Context(), &request.GetManagedDatabaseAccessControlRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: acl,
+		Output: output.Details{
+			Sections: []output.DetailSection{
+				{
+					Title: "Access control settings:",
+					Rows: []output.DetailRow{
+						{Title: "Access control:", Value: acl.ACLsEnabled, Format: format.Boolean},
+						{Title: "Extended access control:", Value: acl.ExtendedACLsEnabled, Format: format.Boolean},
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -29,15 +29,7 @@ func (db *databaseCommand) InitCommand() {
 
 // waitForManagedDatabaseState waits for database to reach given state and updates progress message with key matching given msg. Finally, progress message is updated back to given msg and either done state or timeout warning.
 func WaitForManagedDatabaseState(uuid string, state upcloud.ManagedDatabaseState, exec commands.Executor, msg string) {
-	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for database %s to be in %s state", uuid, state))
-
-	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
-	defer cancel()
-
-	if _, err := exec.All().WaitForManagedDatabaseState(ctx, &request.WaitForManagedDatabaseStateRequest{
-		UUID:         uuid,
-		DesiredState: state,
-	}); err != nil {
+	if err := waitForState(uuid, state, exec, msg); err != nil {
 		exec.PushProgressUpdate(messages.Update{
 			Key:     msg,
 			Message: msg,
@@ -50,3 +42,17 @@ func WaitForManagedDatabaseState(uuid string, state upcloud.ManagedDatabaseState
 	exec.PushProgressUpdateMessage(msg, msg)
 	exec.PushProgressSuccess(msg)
 }
+
+// waitForState waits for database to reach given state and updates progress message with key matching given msg while waiting. Unlike WaitForManagedDatabaseState, it leaves finishing the progress message to the caller.
+func waitForState(uuid string, state upcloud.ManagedDatabaseState, exec commands.Executor, msg string) error {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for database %s to be in %s state", uuid, state))
+
+	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
+	defer cancel()
+
+	_, err := exec.All().WaitForManagedDatabaseState(ctx, &request.WaitForManagedDatabaseStateRequest{
+		UUID:         uuid,
+		DesiredState: state,
+	})
+	return err
+}
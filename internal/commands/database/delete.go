@@ -78,6 +78,12 @@ func Delete(exec commands.Executor, uuid string, disableTerminationProtection, w
 	return output.None{}, nil
 }
 
+// ResourceName implements commands.DestructiveCommand
+func (c *deleteCommand) ResourceName(uuid string) string {
+	database, _ := c.GetCached(uuid)
+	return database.Title
+}
+
 // Execute implements commands.MultipleArgumentCommand
 func (c *deleteCommand) Execute(exec commands.Executor, arg string) (output.Output, error) {
 	return Delete(exec, arg, c.disableTerminationProtection.Value(), c.wait.Value())
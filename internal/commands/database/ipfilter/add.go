@@ -0,0 +1,114 @@
+package databaseipfilter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// AddCommand creates the "database ip-filter add" command
+func AddCommand() commands.Command {
+	return &addCommand{
+		BaseCommand: commands.New(
+			"add",
+			"Allow IP addresses to access a database",
+			"upctl database ip-filter add my-pg-database 203.0.113.10",
+			"upctl database ip-filter add my-pg-database 203.0.113.0/24 198.51.100.20",
+			"upctl database ip-filter add my-pg-database --add-my-ip",
+		),
+	}
+}
+
+type addCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+
+	cfg     *config.Config
+	addMyIP config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (c *addCommand) InitCommand() {
+	c.Cobra().Long = commands.WrapLongDescription(`Allow IP addresses to access a database
+
+The addresses are given as IP addresses or networks in CIDR notation after the database. Addresses that are already in the IP filter are not added again.`)
+	c.Cobra().Args = cobra.MinimumNArgs(1)
+
+	fs := &pflag.FlagSet{}
+	config.AddToggleFlag(fs, &c.addMyIP, "add-my-ip", false, "Allow the IP address defined with `my-ip` key in the config file or `UPCLOUD_MY_IP` environment variable.")
+	c.AddFlags(fs)
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *addCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.cfg = cfg
+	c.Cobra().ValidArgsFunction = addressesCompletionFunc(cfg, nil)
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (c *addCommand) PositionalArgumentHelp() string {
+	return "<UUID/Title> [address...]"
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (c *addCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	args := c.Cobra().Flags().Args()
+	toAdd := slices.Clone(args[1:])
+	if c.addMyIP.Value() {
+		myIP := c.cfg.GetString(config.KeyMyIP)
+		if myIP == "" {
+			return nil, fmt.Errorf("--add-my-ip requires `%s` to be defined in the config file or `UPCLOUD_MY_IP` environment variable", config.KeyMyIP)
+		}
+		toAdd = append(toAdd, myIP)
+	}
+	if len(toAdd) == 0 {
+		return nil, fmt.Errorf("at least one address or --add-my-ip is required")
+	}
+	for _, address := range toAdd {
+		if _, err := parseAddress(address); err != nil {
+			return nil, err
+		}
+	}
+
+	uuid, err := namedargs.Resolve(&c.CachingDatabase, exec, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Adding %s to the IP filter of database %s", strings.Join(toAdd, ", "), uuid)
+	exec.PushProgressStarted(msg)
+
+	addresses, err := getIPFilter(exec, uuid)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	changed := false
+	for _, address := range toAdd {
+		prefix, _ := parseAddress(address)
+		if indexOf(addresses, prefix) >= 0 {
+			continue
+		}
+		addresses = append(addresses, address)
+		changed = true
+	}
+
+	if changed {
+		if err := setIPFilter(exec, uuid, addresses); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: addresses}, nil
+}
@@ -0,0 +1,146 @@
+package databaseipfilter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockDatabase() *upcloud.ManagedDatabase {
+	return &upcloud.ManagedDatabase{
+		UUID:  "0927dfd6-3884-4079-a948-3a8881df1a7a",
+		Title: "my-pg-database",
+		Properties: upcloud.ManagedDatabaseProperties{
+			upcloud.ManagedDatabasePropertyIPFilter: []any{"10.0.0.1", "192.168.0.0/24"},
+		},
+	}
+}
+
+func mockIPFilterService(db *upcloud.ManagedDatabase) *smock.Service {
+	mService := smock.Service{}
+	mService.On("GetManagedDatabases", mock.Anything).Return([]upcloud.ManagedDatabase{*db}, nil)
+	mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(db, nil)
+	mService.On("ModifyManagedDatabase", mock.Anything).Return(db, nil)
+	return &mService
+}
+
+// sentIPFilter returns the IP filter of the ModifyManagedDatabase request sent to the service as JSON.
+func sentIPFilter(t *testing.T, mService *smock.Service) string {
+	t.Helper()
+
+	for _, call := range mService.Calls {
+		if call.Method != "ModifyManagedDatabase" {
+			continue
+		}
+		req, ok := call.Arguments[0].(*request.ModifyManagedDatabaseRequest)
+		require.True(t, ok)
+
+		var body struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		}
+		data, err := json.Marshal(req)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &body))
+		return string(body.Properties[string(upcloud.ManagedDatabasePropertyIPFilter)])
+	}
+	require.Fail(t, "ModifyManagedDatabase was not called")
+	return ""
+}
+
+func TestAddCommand(t *testing.T) {
+	db := mockDatabase()
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		myIP     string
+		expected string
+		error    string
+	}{
+		{
+			name:     "add addresses",
+			args:     []string{db.UUID, "203.0.113.10", "198.51.100.0/24"},
+			expected: `["10.0.0.1","192.168.0.0/24","203.0.113.10","198.51.100.0/24"]`,
+		},
+		{
+			name:     "database by title",
+			args:     []string{db.Title, "203.0.113.10"},
+			expected: `["10.0.0.1","192.168.0.0/24","203.0.113.10"]`,
+		},
+		{
+			name:     "skip existing addresses",
+			args:     []string{db.UUID, "10.0.0.1/32", "203.0.113.10"},
+			expected: `["10.0.0.1","192.168.0.0/24","203.0.113.10"]`,
+		},
+		{
+			name:     "add my ip",
+			args:     []string{db.UUID, "--add-my-ip"},
+			myIP:     "198.51.100.7",
+			expected: `["10.0.0.1","192.168.0.0/24","198.51.100.7"]`,
+		},
+		{
+			name:  "add my ip without config",
+			args:  []string{db.UUID, "--add-my-ip"},
+			error: "--add-my-ip requires `my-ip` to be defined",
+		},
+		{
+			name:  "no addresses",
+			args:  []string{db.UUID},
+			error: "at least one address or --add-my-ip is required",
+		},
+		{
+			name:  "no database",
+			args:  []string{},
+			error: "requires at least 1 arg(s), only received 0",
+		},
+		{
+			name:  "invalid address",
+			args:  []string{db.UUID, "10.0.0.300"},
+			error: `invalid IP address or network "10.0.0.300"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := mockIPFilterService(mockDatabase())
+
+			conf := config.New()
+			if test.myIP != "" {
+				conf.Viper().Set(config.KeyMyIP, test.myIP)
+			}
+			c := commands.BuildCommand(AddCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.ErrorContains(t, err, test.error)
+				mService.AssertNotCalled(t, "ModifyManagedDatabase", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			mService.AssertNumberOfCalls(t, "ModifyManagedDatabase", 1)
+			assert.JSONEq(t, test.expected, sentIPFilter(t, mService))
+		})
+	}
+}
+
+func TestAddCommand_NoChanges(t *testing.T) {
+	db := mockDatabase()
+	mService := mockIPFilterService(db)
+
+	conf := config.New()
+	c := commands.BuildCommand(AddCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{db.UUID, "192.168.0.0/24"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.NoError(t, err)
+	mService.AssertNotCalled(t, "ModifyManagedDatabase", mock.Anything)
+}
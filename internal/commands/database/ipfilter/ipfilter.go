@@ -0,0 +1,91 @@
+package databaseipfilter
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+)
+
+// BaseIPFilterCommand creates the base "database ip-filter" command
+func BaseIPFilterCommand() commands.Command {
+	return &ipFilterCommand{
+		commands.New("ip-filter", "Manage the IP addresses allowed to access a database"),
+	}
+}
+
+type ipFilterCommand struct {
+	*commands.BaseCommand
+}
+
+// addressesCompletionFunc completes databases for the first positional argument and, if values is given, the values of the database for the rest of the arguments.
+func addressesCompletionFunc(cfg *config.Config, values namedargs.ValuesFunc) namedargs.CompleteFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return namedargs.CompletionFunc(completion.Database{}, cfg)(cmd, args, toComplete)
+		}
+		if values == nil {
+			return completion.None(toComplete)
+		}
+		return namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingDatabase{}, cfg, values)(cmd, args, toComplete)
+	}
+}
+
+// getIPFilter returns the IP addresses and networks currently allowed to access the database.
+func getIPFilter(exec commands.Executor, uuid string) ([]string, error) {
+	db, err := exec.All().GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := db.Properties.GetIPFilter()
+	if addresses == nil {
+		addresses = []string{}
+	}
+	return addresses, nil
+}
+
+// setIPFilter replaces the IP filter of the database with the given addresses.
+func setIPFilter(exec commands.Executor, uuid string, addresses []string) error {
+	req := request.ModifyManagedDatabaseRequest{UUID: uuid}
+	req.Properties.SetIPFilter(addresses...)
+
+	_, err := exec.All().ModifyManagedDatabase(exec.Context(), &req)
+	return err
+}
+
+// parseAddress parses an IPv4 address or network in CIDR notation. Addresses without a mask are handled as single host networks.
+func parseAddress(address string) (netip.Prefix, error) {
+	if strings.Contains(address, "/") {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid IP address or network %q: %w", address, err)
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IP address or network %q: %w", address, err)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// indexOf returns the index of the entry in addresses that matches address, or -1 if there is no match. Entries are compared as networks, so that e.g. `10.0.0.1` matches `10.0.0.1/32`.
+func indexOf(addresses []string, address netip.Prefix) int {
+	for i, entry := range addresses {
+		prefix, err := parseAddress(entry)
+		if err == nil && prefix == address {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,50 @@
+package databaseipfilter
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+)
+
+// ListCommand creates the "database ip-filter list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List the IP addresses allowed to access a database",
+			"upctl database ip-filter list my-pg-database",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *listCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	addresses, err := getIPFilter(exec, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]output.TableRow, 0, len(addresses))
+	for _, address := range addresses {
+		rows = append(rows, output.TableRow{address})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: addresses,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "address", Header: "Address", Colour: ui.DefaultAddressColours},
+			},
+			Rows:         rows,
+			EmptyMessage: "No IP addresses are allowed to access this database.",
+		},
+	}, nil
+}
@@ -0,0 +1,103 @@
+package databaseipfilter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+)
+
+// RemoveCommand creates the "database ip-filter remove" command
+func RemoveCommand() commands.Command {
+	return &removeCommand{
+		BaseCommand: commands.New(
+			"remove",
+			"Remove IP addresses from the IP filter of a database",
+			"upctl database ip-filter remove my-pg-database 203.0.113.10",
+			"upctl database ip-filter remove my-pg-database 203.0.113.0/24 198.51.100.20",
+		),
+	}
+}
+
+type removeCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+}
+
+// InitCommand implements Command.InitCommand
+func (c *removeCommand) InitCommand() {
+	c.Cobra().Long = commands.WrapLongDescription(`Remove IP addresses from the IP filter of a database
+
+The addresses are given as IP addresses or networks in CIDR notation after the database.`)
+	c.Cobra().Args = cobra.MinimumNArgs(2)
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *removeCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.Cobra().ValidArgsFunction = addressesCompletionFunc(cfg, ipFilterAddresses)
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (c *removeCommand) PositionalArgumentHelp() string {
+	return "<UUID/Title> <address...>"
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (c *removeCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	args := c.Cobra().Flags().Args()
+	toRemove := args[1:]
+	for _, address := range toRemove {
+		if _, err := parseAddress(address); err != nil {
+			return nil, err
+		}
+	}
+
+	uuid, err := namedargs.Resolve(&c.CachingDatabase, exec, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Removing %s from the IP filter of database %s", strings.Join(toRemove, ", "), uuid)
+	exec.PushProgressStarted(msg)
+
+	addresses, err := getIPFilter(exec, uuid)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	for _, address := range toRemove {
+		prefix, _ := parseAddress(address)
+		i := indexOf(addresses, prefix)
+		if i < 0 {
+			return commands.HandleError(exec, msg, fmt.Errorf("IP address %s not found in the IP filter of database %s", address, uuid))
+		}
+		addresses = slices.Delete(addresses, i, i+1)
+	}
+
+	if err := setIPFilter(exec, uuid, addresses); err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: addresses}, nil
+}
+
+// ipFilterAddresses returns the addresses in the IP filter of the given database. Use with namedargs.ResolvedArgumentCompletionFunc to complete the addresses.
+func ipFilterAddresses(ctx context.Context, svc service.AllServices, uuid string) ([]string, error) {
+	db, err := svc.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+	return db.Properties.GetIPFilter(), nil
+}
@@ -0,0 +1,63 @@
+package databaseipfilter
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRemoveCommand(t *testing.T) {
+	db := mockDatabase()
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected string
+		error    string
+	}{
+		{
+			name:     "remove address",
+			args:     []string{db.UUID, "10.0.0.1/32"},
+			expected: `["192.168.0.0/24"]`,
+		},
+		{
+			// The IP filter must be sent as an empty list instead of null to remove all entries
+			name:     "remove all addresses",
+			args:     []string{db.Title, "10.0.0.1", "192.168.0.0/24"},
+			expected: `[]`,
+		},
+		{
+			name:  "no addresses",
+			args:  []string{db.UUID},
+			error: "requires at least 2 arg(s), only received 1",
+		},
+		{
+			name:  "address not in filter",
+			args:  []string{db.UUID, "203.0.113.10"},
+			error: "IP address 203.0.113.10 not found in the IP filter of database " + db.UUID,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := mockIPFilterService(mockDatabase())
+
+			conf := config.New()
+			c := commands.BuildCommand(RemoveCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, "ModifyManagedDatabase", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			mService.AssertNumberOfCalls(t, "ModifyManagedDatabase", 1)
+			assert.Equal(t, test.expected, sentIPFilter(t, mService))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/paging"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
@@ -52,6 +53,11 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			db.Plan,
 			db.Zone,
 			db.State,
+			db.NodeCount,
+			db.Powered,
+			db.TerminationProtection,
+			db.CreateTime,
+			labels.Cell(db.Labels),
 		})
 	}
 
@@ -65,6 +71,11 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "plan", Header: "Plan"},
 				{Key: "zone", Header: "Zone"},
 				{Key: "state", Header: "State", Format: format.DatabaseState},
+				{Key: "node_count", Header: "Nodes", Hidden: true},
+				{Key: "powered", Header: "Powered", Format: format.Boolean, Hidden: true},
+				{Key: "termination_protection", Header: "Termination protection", Format: format.Boolean, Hidden: true},
+				{Key: "create_time", Header: "Created", Hidden: true},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
@@ -0,0 +1,264 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	logsFollowInterval = 2 * time.Second
+	// logsCatchUpInterval limits the request rate while paging through the existing log lines in follow mode.
+	logsCatchUpInterval = 250 * time.Millisecond
+)
+
+var logOrders = []string{
+	string(upcloud.ManagedDatabaseLogOrderAscending),
+	string(upcloud.ManagedDatabaseLogOrderDescending),
+}
+
+// LogsCommand creates the "database logs" command
+func LogsCommand() commands.Command {
+	return &logsCommand{
+		BaseCommand: commands.New(
+			"logs",
+			"Show database logs",
+			"upctl database logs my-pg-database",
+			"upctl database logs my-pg-database --since 1h --grep ERROR",
+			"upctl database logs my-pg-database --follow",
+			"upctl database logs my-pg-database --follow --output json",
+		),
+	}
+}
+
+type logsCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+
+	cfg    *config.Config
+	since  time.Duration
+	limit  int
+	order  string
+	grep   string
+	follow config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (c *logsCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.DurationVar(&c.since, "since", 0, "Only show log lines newer than the given duration, e.g. `1h`.")
+	fs.IntVar(&c.limit, "limit", 100, "Maximum number of log lines to fetch per request.")
+	fs.StringVar(&c.order, "order", string(upcloud.ManagedDatabaseLogOrderAscending), "Order of the log lines. Valid values are "+namedargs.ValidValuesHelp(logOrders...)+".")
+	fs.StringVar(&c.grep, "grep", "", "Only show log lines with a message matching the given regular expression.")
+	config.AddToggleFlag(fs, &c.follow, "follow", false, "Keep polling for new log lines until interrupted. The existing log lines are only shown if `--since` is given. New log lines are shown after paging through the existing log lines, which may take a while for databases with long log history. Implies ascending order.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("since", cobra.NoFileCompletions))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("limit", cobra.NoFileCompletions))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("order", cobra.FixedCompletions(logOrders, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("grep", cobra.NoFileCompletions))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *logsCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.cfg = cfg
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *logsCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	if c.limit < 1 {
+		return nil, fmt.Errorf("invalid --limit value %d, limit must be at least 1", c.limit)
+	}
+	if c.since < 0 {
+		return nil, fmt.Errorf("invalid --since value %s, duration must be positive", c.since)
+	}
+	order := upcloud.ManagedDatabaseLogOrder(c.order)
+	if order != upcloud.ManagedDatabaseLogOrderAscending && order != upcloud.ManagedDatabaseLogOrderDescending {
+		return nil, fmt.Errorf("invalid --order value %s, valid values are %s", c.order, namedargs.ValidValuesHelp(logOrders...))
+	}
+	if c.follow.Value() && order != upcloud.ManagedDatabaseLogOrderAscending {
+		return nil, fmt.Errorf("--follow can not be used with --order %s", c.order)
+	}
+
+	var pattern *regexp.Regexp
+	if c.grep != "" {
+		var err error
+		pattern, err = regexp.Compile(c.grep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --grep pattern: %w", err)
+		}
+	}
+
+	var since time.Time
+	if c.since > 0 {
+		since = time.Now().Add(-c.since)
+	}
+	filter := func(entries []upcloud.ManagedDatabaseLogEntry) []upcloud.ManagedDatabaseLogEntry {
+		filtered := []upcloud.ManagedDatabaseLogEntry{}
+		for _, entry := range entries {
+			if !since.IsZero() && entry.Time.Before(since) {
+				continue
+			}
+			if pattern != nil && !pattern.MatchString(entry.Message) {
+				continue
+			}
+			filtered = append(filtered, entry)
+		}
+		return filtered
+	}
+
+	w := c.Cobra().OutOrStdout()
+	if !c.follow.Value() {
+		entries, err := c.fetchLogs(exec, uuid, order, since)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.writeLogs(w, filter(entries)); err != nil {
+			return nil, err
+		}
+		return output.None{}, nil
+	}
+
+	req := request.GetManagedDatabaseLogsRequest{
+		UUID:  uuid,
+		Limit: c.limit,
+		Order: order,
+	}
+	// The existing log lines are skipped, unless --since is given, so that only the lines added while following are printed.
+	history := true
+	for {
+		logs, err := exec.All().GetManagedDatabaseLogs(exec.Context(), &req)
+		if err != nil {
+			if exec.Context().Err() != nil {
+				return output.None{}, nil
+			}
+			return nil, err
+		}
+		if !history || !since.IsZero() {
+			if err := c.writeLogs(w, filter(logs.Logs)); err != nil {
+				return nil, err
+			}
+		}
+		if logs.Offset != "" {
+			req.Offset = logs.Offset
+		}
+
+		// Fetch the next page sooner while catching up with the existing logs, but limit the request rate to avoid flooding the API.
+		interval := logsFollowInterval
+		if len(logs.Logs) >= c.limit {
+			interval = logsCatchUpInterval
+		} else {
+			history = false
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-exec.Context().Done():
+			return output.None{}, nil
+		}
+	}
+}
+
+// fetchLogs fetches a page of log lines in the given order. If since is set, pages are fetched in descending order until log lines older than since are reached, so that all lines newer than since are returned.
+func (c *logsCommand) fetchLogs(exec commands.Executor, uuid string, order upcloud.ManagedDatabaseLogOrder, since time.Time) ([]upcloud.ManagedDatabaseLogEntry, error) {
+	req := request.GetManagedDatabaseLogsRequest{
+		UUID:  uuid,
+		Limit: c.limit,
+		Order: order,
+	}
+	if since.IsZero() {
+		logs, err := exec.All().GetManagedDatabaseLogs(exec.Context(), &req)
+		if err != nil {
+			return nil, err
+		}
+		return logs.Logs, nil
+	}
+
+	req.Order = upcloud.ManagedDatabaseLogOrderDescending
+	entries := []upcloud.ManagedDatabaseLogEntry{}
+	for {
+		logs, err := exec.All().GetManagedDatabaseLogs(exec.Context(), &req)
+		if err != nil {
+			return nil, err
+		}
+
+		reachedSince := false
+		for _, entry := range logs.Logs {
+			if entry.Time.Before(since) {
+				reachedSince = true
+				break
+			}
+			entries = append(entries, entry)
+		}
+		if reachedSince || len(logs.Logs) < c.limit || logs.Offset == "" || logs.Offset == req.Offset {
+			break
+		}
+		req.Offset = logs.Offset
+	}
+
+	if order == upcloud.ManagedDatabaseLogOrderAscending {
+		slices.Reverse(entries)
+	}
+	return entries, nil
+}
+
+// writeLogs writes the given log entries to w. JSON output is written as one object per line to make the output easy to ship to log pipelines.
+func (c *logsCommand) writeLogs(w io.Writer, entries []upcloud.ManagedDatabaseLogEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	switch {
+	case c.cfg.Output() == config.ValueOutputJSON:
+		for _, entry := range entries {
+			line, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "%s\n", line); err != nil {
+				return err
+			}
+		}
+		return nil
+	case c.cfg.OutputHuman():
+		for _, entry := range entries {
+			if _, err := fmt.Fprintf(w, "%s %s %s: %s\n", entry.Time.Format(time.RFC3339), entry.Hostname, entry.Service, entry.Message); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		rows := []output.TableRow{}
+		for _, entry := range entries {
+			rows = append(rows, output.TableRow{entry.Time, entry.Hostname, entry.Service, entry.Message})
+		}
+		return commands.RenderOutput(w, c.cfg, output.MarshaledWithHumanOutput{
+			Value: entries,
+			Output: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "time", Header: "Time"},
+					{Key: "hostname", Header: "Hostname"},
+					{Key: "service", Header: "Service"},
+					{Key: "msg", Header: "Message"},
+				},
+				Rows: rows,
+			},
+		})
+	}
+}
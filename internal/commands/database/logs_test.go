@@ -0,0 +1,201 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const logsTestUUID = "0927dfd6-3884-4079-a948-3a8881df1a7a"
+
+func logsTestEntries() []upcloud.ManagedDatabaseLogEntry {
+	now := time.Now().UTC().Truncate(time.Second)
+	return []upcloud.ManagedDatabaseLogEntry{
+		{Hostname: "mydb-1", Service: "postgresql-16", Message: "LOG:  checkpoint starting", Time: now.Add(-2 * time.Hour)},
+		{Hostname: "mydb-1", Service: "postgresql-16", Message: "ERROR:  relation \"foo\" does not exist", Time: now.Add(-30 * time.Minute)},
+		{Hostname: "mydb-1", Service: "postgresql-16", Message: "LOG:  checkpoint complete", Time: now.Add(-10 * time.Minute)},
+	}
+}
+
+func TestLogsCommand(t *testing.T) {
+	entries := logsTestEntries()
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		req      request.GetManagedDatabaseLogsRequest
+		logs     []upcloud.ManagedDatabaseLogEntry
+		expected []string
+		error    string
+	}{
+		{
+			name:     "defaults",
+			args:     []string{logsTestUUID},
+			req:      request.GetManagedDatabaseLogsRequest{UUID: logsTestUUID, Limit: 100, Order: upcloud.ManagedDatabaseLogOrderAscending},
+			expected: []string{entries[0].Message, entries[1].Message, entries[2].Message},
+		},
+		{
+			name:     "grep",
+			args:     []string{logsTestUUID, "--grep", "^LOG:", "--limit", "10"},
+			req:      request.GetManagedDatabaseLogsRequest{UUID: logsTestUUID, Limit: 10, Order: upcloud.ManagedDatabaseLogOrderAscending},
+			expected: []string{entries[0].Message, entries[2].Message},
+		},
+		{
+			name:     "since and order",
+			args:     []string{logsTestUUID, "--since", "1h", "--order", "desc"},
+			req:      request.GetManagedDatabaseLogsRequest{UUID: logsTestUUID, Limit: 100, Order: upcloud.ManagedDatabaseLogOrderDescending},
+			logs:     []upcloud.ManagedDatabaseLogEntry{entries[2], entries[1], entries[0]},
+			expected: []string{entries[2].Message, entries[1].Message},
+		},
+		{
+			name:  "invalid order",
+			args:  []string{logsTestUUID, "--order", "random"},
+			error: "invalid --order value random",
+		},
+		{
+			name:  "invalid grep",
+			args:  []string{logsTestUUID, "--grep", "("},
+			error: "invalid --grep pattern",
+		},
+		{
+			name:  "follow with descending order",
+			args:  []string{logsTestUUID, "--follow", "--order", "desc"},
+			error: "--follow can not be used with --order desc",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			logs := test.logs
+			if logs == nil {
+				logs = entries
+			}
+			mService := smock.Service{}
+			mService.On("GetManagedDatabaseLogs", &test.req).Return(&upcloud.ManagedDatabaseLogs{Logs: logs}, nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(LogsCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.ErrorContains(t, err, test.error)
+				mService.AssertNotCalled(t, "GetManagedDatabaseLogs", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			mService.AssertNumberOfCalls(t, "GetManagedDatabaseLogs", 1)
+			lines := strings.Split(strings.TrimSpace(out), "\n")
+			assert.Len(t, lines, len(test.expected))
+			for i, msg := range test.expected {
+				assert.Contains(t, lines[i], msg)
+			}
+		})
+	}
+}
+
+func TestLogsCommand_SincePaging(t *testing.T) {
+	entries := logsTestEntries()
+
+	mService := smock.Service{}
+	for i, offset := range []string{"", "1", "2"} {
+		mService.On("GetManagedDatabaseLogs", &request.GetManagedDatabaseLogsRequest{
+			UUID:   logsTestUUID,
+			Limit:  1,
+			Offset: offset,
+			Order:  upcloud.ManagedDatabaseLogOrderDescending,
+		}).Return(&upcloud.ManagedDatabaseLogs{Offset: fmt.Sprint(i + 1), Logs: entries[2-i : 3-i]}, nil)
+	}
+
+	conf := config.New()
+	c := commands.BuildCommand(LogsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{logsTestUUID, "--since", "1h", "--limit", "1"})
+	out, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.NoError(t, err)
+	mService.AssertNumberOfCalls(t, "GetManagedDatabaseLogs", 3)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], entries[1].Message)
+	assert.Contains(t, lines[1], entries[2].Message)
+}
+
+func TestLogsCommand_FollowJSON(t *testing.T) {
+	entries := logsTestEntries()
+	newEntry := upcloud.ManagedDatabaseLogEntry{Hostname: "mydb-1", Service: "postgresql-16", Message: "LOG:  new connection", Time: time.Now().UTC()}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected []upcloud.ManagedDatabaseLogEntry
+	}{
+		{
+			name:     "skip history",
+			args:     []string{logsTestUUID, "--follow", "--limit", "2"},
+			expected: []upcloud.ManagedDatabaseLogEntry{newEntry},
+		},
+		{
+			name:     "since",
+			args:     []string{logsTestUUID, "--follow", "--limit", "2", "--since", "1h"},
+			expected: []upcloud.ManagedDatabaseLogEntry{entries[1], entries[2], newEntry},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+
+			// Pages of existing log lines are fetched with a limited rate
+			var firstPageAt, secondPageAt time.Time
+			mService := smock.Service{}
+			mService.On("GetManagedDatabaseLogs", &request.GetManagedDatabaseLogsRequest{
+				UUID:  logsTestUUID,
+				Limit: 2,
+				Order: upcloud.ManagedDatabaseLogOrderAscending,
+			}).Return(&upcloud.ManagedDatabaseLogs{Offset: "2", Logs: entries[:2]}, nil).Run(func(mock.Arguments) {
+				firstPageAt = time.Now()
+			})
+			mService.On("GetManagedDatabaseLogs", &request.GetManagedDatabaseLogsRequest{
+				UUID:   logsTestUUID,
+				Limit:  2,
+				Offset: "2",
+				Order:  upcloud.ManagedDatabaseLogOrderAscending,
+			}).Return(&upcloud.ManagedDatabaseLogs{Offset: "3", Logs: entries[2:]}, nil).Run(func(mock.Arguments) {
+				secondPageAt = time.Now()
+			})
+			mService.On("GetManagedDatabaseLogs", &request.GetManagedDatabaseLogsRequest{
+				UUID:   logsTestUUID,
+				Limit:  2,
+				Offset: "3",
+				Order:  upcloud.ManagedDatabaseLogOrderAscending,
+			}).Return(&upcloud.ManagedDatabaseLogs{Offset: "4", Logs: []upcloud.ManagedDatabaseLogEntry{newEntry}}, nil).Run(func(mock.Arguments) {
+				conf.Cancel()
+			})
+
+			c := commands.BuildCommand(LogsCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, &mService, conf)
+
+			assert.NoError(t, err)
+			mService.AssertNumberOfCalls(t, "GetManagedDatabaseLogs", 3)
+			assert.GreaterOrEqual(t, secondPageAt.Sub(firstPageAt), logsCatchUpInterval)
+
+			lines := strings.Split(strings.TrimSpace(out), "\n")
+			assert.Len(t, lines, len(test.expected))
+			for i, line := range lines {
+				assert.True(t, strings.HasPrefix(line, "{"))
+				assert.Contains(t, line, `"hostname":"mydb-1"`)
+				assert.Contains(t, line, strings.ReplaceAll(test.expected[i].Message, `"`, `\"`))
+			}
+		})
+	}
+}
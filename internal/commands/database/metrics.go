@@ -0,0 +1,214 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const metricsChartWidth = 30
+
+var metricPeriods = []string{
+	string(upcloud.ManagedDatabaseMetricPeriodHour),
+	string(upcloud.ManagedDatabaseMetricPeriodDay),
+	string(upcloud.ManagedDatabaseMetricPeriodWeek),
+	string(upcloud.ManagedDatabaseMetricPeriodMonth),
+	string(upcloud.ManagedDatabaseMetricPeriodYear),
+}
+
+// metricSeries contains the values of a single metrics chart converted to float64 values.
+type metricSeries struct {
+	key     string
+	title   string
+	columns []upcloud.ManagedDatabaseMetricsColumn
+	rows    [][]float64
+}
+
+func float64Series(key, title string, chart upcloud.ManagedDatabaseMetricsChartFloat64) metricSeries {
+	return metricSeries{key: key, title: title, columns: chart.Columns, rows: chart.Rows}
+}
+
+func intSeries(key, title string, chart upcloud.ManagedDatabaseMetricsChartInt) metricSeries {
+	rows := make([][]float64, 0, len(chart.Rows))
+	for _, row := range chart.Rows {
+		values := make([]float64, 0, len(row))
+		for _, v := range row {
+			values = append(values, float64(v))
+		}
+		rows = append(rows, values)
+	}
+	return metricSeries{key: key, title: title, columns: chart.Columns, rows: rows}
+}
+
+func metricsSeries(metrics *upcloud.ManagedDatabaseMetrics) []metricSeries {
+	return []metricSeries{
+		float64Series("cpu", "CPU usage (%)", metrics.CPUUsage),
+		float64Series("memory", "Memory usage (%)", metrics.MemoryUsage),
+		float64Series("disk", "Disk usage (%)", metrics.DiskUsage),
+		intSeries("diskio-reads", "Disk IOPS (reads)", metrics.DiskIOReads),
+		intSeries("diskio-writes", "Disk IOPS (writes)", metrics.DiskIOWrite),
+		float64Series("load", "Load average (5 min)", metrics.LoadAverage),
+	}
+}
+
+func metricKeys() []string {
+	var keys []string
+	for _, series := range metricsSeries(&upcloud.ManagedDatabaseMetrics{}) {
+		keys = append(keys, series.key)
+	}
+	return keys
+}
+
+// MetricsCommand creates the "database metrics" command
+func MetricsCommand() commands.Command {
+	return &metricsCommand{
+		BaseCommand: commands.New(
+			"metrics",
+			"Show database metrics",
+			"upctl database metrics my-pg-database",
+			"upctl database metrics my-pg-database --period week",
+			"upctl database metrics my-pg-database --threshold cpu=80 --threshold disk=90",
+			"upctl database metrics my-pg-database --output json",
+		),
+	}
+}
+
+type metricsCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+
+	period     string
+	thresholds []string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *metricsCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.period, "period", string(upcloud.ManagedDatabaseMetricPeriodDay), "Observation period of the metrics. Valid values are "+namedargs.ValidValuesHelp(metricPeriods...)+".")
+	fs.StringArrayVar(&c.thresholds, "threshold", nil, "Fail the command if the latest value of a metric on any node exceeds the given threshold, e.g. `cpu=80`, multiple can be declared. Valid metrics are "+namedargs.ValidValuesHelp(metricKeys()...)+".")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("period", cobra.FixedCompletions(metricPeriods, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("threshold", cobra.NoFileCompletions))
+}
+
+func parseThresholds(in []string) (map[string]float64, error) {
+	keys := metricKeys()
+	thresholds := make(map[string]float64)
+	for _, v := range in {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid threshold %q, expected format is metric=value", v)
+		}
+		if !slices.Contains(keys, key) {
+			return nil, fmt.Errorf("invalid threshold metric %q, valid metrics are %s", key, namedargs.ValidValuesHelp(keys...))
+		}
+		limit, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold value %q for %s: %w", value, key, err)
+		}
+		thresholds[key] = limit
+	}
+	return thresholds, nil
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *metricsCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	if !slices.Contains(metricPeriods, c.period) {
+		return nil, fmt.Errorf("invalid --period value %s, valid values are %s", c.period, namedargs.ValidValuesHelp(metricPeriods...))
+	}
+	thresholds, err := parseThresholds(c.thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := exec.All().GetManagedDatabaseMetrics(exec.Context(), &request.GetManagedDatabaseMetricsRequest{
+		UUID:   uuid,
+		Period: upcloud.ManagedDatabaseMetricPeriod(c.period),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	var exceeded []error
+	for _, series := range metricsSeries(metrics) {
+		for i, column := range series.columns {
+			values := make([]float64, 0, len(series.rows))
+			for _, row := range series.rows {
+				if i < len(row) {
+					values = append(values, row[i])
+				}
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			latest := values[len(values)-1]
+			if limit, ok := thresholds[series.key]; ok && latest > limit {
+				exceeded = append(exceeded, fmt.Errorf("%s of %s is %s, which exceeds the threshold %s", series.key, column.Label, formatMetric(latest), formatMetric(limit)))
+			}
+
+			rows = append(rows, output.TableRow{
+				series.title,
+				column.Label,
+				latest,
+				slices.Min(values),
+				slices.Max(values),
+				ui.Sparkline(values, metricsChartWidth),
+			})
+		}
+	}
+
+	out := output.MarshaledWithHumanOutput{
+		Value: metrics,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "metric", Header: "Metric"},
+				{Key: "node", Header: "Node"},
+				{Key: "latest", Header: "Latest", Format: formatMetricValue},
+				{Key: "min", Header: "Min", Format: formatMetricValue},
+				{Key: "max", Header: "Max", Format: formatMetricValue},
+				{Key: "chart", Header: "Chart (" + c.period + ")"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No metrics available for this database.",
+		},
+	}
+
+	if len(exceeded) == 0 {
+		return out, nil
+	}
+
+	// The metrics are rendered also when a threshold is exceeded, so that the values are visible in health check logs.
+	return out, clierrors.CheckFailedError{Err: fmt.Errorf("metric threshold exceeded: %w", errors.Join(exceeded...))}
+}
+
+func formatMetric(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatMetricValue(val any) (text.Colors, string, error) {
+	v, ok := val.(float64)
+	if !ok {
+		return nil, fmt.Sprint(val), nil
+	}
+	return nil, strconv.FormatFloat(v, 'f', 2, 64), nil
+}
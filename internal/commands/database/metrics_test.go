@@ -0,0 +1,146 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockDatabaseMetrics() *upcloud.ManagedDatabaseMetrics {
+	now := time.Now().UTC().Truncate(time.Minute)
+	timestamps := []time.Time{now.Add(-2 * time.Minute), now.Add(-time.Minute), now}
+	nodes := []upcloud.ManagedDatabaseMetricsColumn{
+		{Label: "mydb-1 (master)", Type: "number"},
+		{Label: "mydb-2 (standby)", Type: "number"},
+	}
+	float64Chart := func(rows [][]float64) upcloud.ManagedDatabaseMetricsChartFloat64 {
+		return upcloud.ManagedDatabaseMetricsChartFloat64{
+			ManagedDatabaseMetricsChartHeader: upcloud.ManagedDatabaseMetricsChartHeader{Columns: nodes, Timestamps: timestamps},
+			Rows:                              rows,
+		}
+	}
+	intChart := func(rows [][]int) upcloud.ManagedDatabaseMetricsChartInt {
+		return upcloud.ManagedDatabaseMetricsChartInt{
+			ManagedDatabaseMetricsChartHeader: upcloud.ManagedDatabaseMetricsChartHeader{Columns: nodes, Timestamps: timestamps},
+			Rows:                              rows,
+		}
+	}
+
+	return &upcloud.ManagedDatabaseMetrics{
+		CPUUsage:    float64Chart([][]float64{{10, 5}, {50, 6}, {90.5, 7}}),
+		MemoryUsage: float64Chart([][]float64{{40, 30}, {41, 30}, {42, 31}}),
+		DiskUsage:   float64Chart([][]float64{{20, 20}, {20, 20}, {21, 21}}),
+		DiskIOReads: intChart([][]int{{1, 0}, {2, 0}, {3, 0}}),
+		DiskIOWrite: intChart([][]int{{10, 5}, {20, 5}, {30, 5}}),
+		LoadAverage: float64Chart([][]float64{{0.5, 0.1}, {0.7, 0.1}, {0.6, 0.2}}),
+	}
+}
+
+func TestMetricsCommand(t *testing.T) {
+	text.DisableColors()
+	uuid := "0927dfd6-3884-4079-a948-3a8881df1a7a"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		period   upcloud.ManagedDatabaseMetricPeriod
+		expected []string
+		error    string
+	}{
+		{
+			name:   "human output",
+			args:   []string{uuid},
+			period: upcloud.ManagedDatabaseMetricPeriodDay,
+			expected: []string{
+				"Chart (day)",
+				"CPU usage (%)",
+				"mydb-1 (master)",
+				"90.50",
+				"▁▄█",
+				"Disk IOPS (writes)",
+				"Load average (5 min)",
+			},
+		},
+		{
+			name:     "threshold not exceeded",
+			args:     []string{uuid, "--period", "hour", "--threshold", "cpu=95", "--threshold", "disk=80"},
+			period:   upcloud.ManagedDatabaseMetricPeriodHour,
+			expected: []string{"Chart (hour)", "Memory usage (%)"},
+		},
+		{
+			name:     "threshold exceeded",
+			args:     []string{uuid, "--threshold", "cpu=80", "--threshold", "memory=90"},
+			period:   upcloud.ManagedDatabaseMetricPeriodDay,
+			expected: []string{"CPU usage (%)"},
+			error:    "cpu of mydb-1 (master) is 90.5, which exceeds the threshold 80",
+		},
+		{
+			name:  "invalid threshold metric",
+			args:  []string{uuid, "--threshold", "iops=80"},
+			error: `invalid threshold metric "iops"`,
+		},
+		{
+			name:  "invalid threshold format",
+			args:  []string{uuid, "--threshold", "cpu"},
+			error: `invalid threshold "cpu"`,
+		},
+		{
+			name:  "invalid period",
+			args:  []string{uuid, "--period", "decade"},
+			error: "invalid --period value decade",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := smock.Service{}
+			mService.On("GetManagedDatabaseMetrics", &request.GetManagedDatabaseMetricsRequest{UUID: uuid, Period: test.period}).Return(mockDatabaseMetrics(), nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(MetricsCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.ErrorContains(t, err, test.error)
+				if test.period != "" {
+					assert.ErrorAs(t, err, &clierrors.CheckFailedError{})
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if test.period == "" {
+				mService.AssertNotCalled(t, "GetManagedDatabaseMetrics", mock.Anything)
+			}
+			for _, expected := range test.expected {
+				assert.Contains(t, out, expected)
+			}
+		})
+	}
+}
+
+func TestMetricsCommand_JSON(t *testing.T) {
+	uuid := "0927dfd6-3884-4079-a948-3a8881df1a7a"
+
+	mService := smock.Service{}
+	mService.On("GetManagedDatabaseMetrics", mock.Anything).Return(mockDatabaseMetrics(), nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(MetricsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{uuid})
+	out, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.NoError(t, err)
+	assert.Contains(t, out, `"cpu_usage"`)
+	assert.Contains(t, out, `"label": "mydb-2 (standby)"`)
+	assert.NotContains(t, out, "▁")
+}
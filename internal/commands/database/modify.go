@@ -0,0 +1,238 @@
+package database
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+
+	params                request.ModifyManagedDatabaseRequest
+	labels                []string
+	networks              []string
+	properties            []string
+	terminationProtection config.OptionalBoolean
+	wait                  config.OptionalBoolean
+}
+
+// ModifyCommand creates the "database modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a managed database",
+			"upctl database modify mydb --plan 2x4xCPU-8GB-100GB --wait",
+			"upctl database modify 0497728e-76ef-41d0-997f-fa9449eb71bc --title my-renamed-db --label env=prod",
+			"upctl database modify mydb --property max_connections=300 --property sql_mode=ANSI",
+			"upctl database modify mydb --maintenance-dow sunday --maintenance-time 02:00:00",
+			"upctl database modify mydb --disable-termination-protection",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	flags := &pflag.FlagSet{}
+	flags.StringVar(&s.params.Title, "title", "", "New title for the database.")
+	flags.StringVar(&s.params.Plan, "plan", "", "New plan for the database. Run `upctl database plans [database type]` to list all available plans.")
+	flags.StringVar(&s.params.Zone, "zone", "", "Zone to move the database to. Run `upctl zone list` to list all available zones.")
+	flags.StringVar(&s.params.Maintenance.DayOfWeek, "maintenance-dow", "", "Full name of weekday in English, lower case(sunday) for automatic maintenance day of the week.")
+	flags.StringVar(&s.params.Maintenance.Time, "maintenance-time", "", "Database time in UTC of automatic maintenance HH:MM:SS.")
+	flags.StringSliceVar(&s.labels, "label", nil, "Labels to describe the database in `key=value` format, multiple can be declared. Replaces the existing labels.\nUsage: --label env=dev\n\n--label owner=operations")
+	flags.StringArrayVar(&s.networks, "network", nil, "A network interface for the database, multiple can be declared. Replaces the existing networks.\nUsage: --network name=network-name,family=IPv4,type=private,uuid=030e83d2-d413-4d19-b1c9-af05cdb60c1f")
+	flags.StringArrayVar(&s.properties, "property", nil, "Properties to modify in `key=value` format. Can be specified multiple times. Run `upctl database properties [database type]` to list available properties.")
+	config.AddEnableDisableFlags(flags, &s.terminationProtection, "termination-protection", "termination protection to prevent the database instance from being powered off or deleted")
+	config.AddToggleFlag(flags, &s.wait, "wait", false, "Wait for database to be in running state before returning.")
+
+	s.AddFlags(flags)
+
+	for _, flag := range []string{"title", "plan", "maintenance-dow", "maintenance-time", "label", "network", "property"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	req := s.params
+	req.UUID = uuid
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return nil, err
+		}
+		req.Labels = &labelSlice
+	}
+
+	if len(s.networks) > 0 {
+		networks, err := processNetworks(s.networks)
+		if err != nil {
+			return nil, fmt.Errorf("invalid networks: %w", err)
+		}
+		req.Networks = &networks
+	}
+
+	if s.terminationProtection.IsSet() {
+		terminationProtection := s.terminationProtection.Value()
+		req.TerminationProtection = &terminationProtection
+	}
+
+	msg := fmt.Sprintf("Modifying database %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	if len(s.properties) > 0 {
+		db, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		t, err := svc.GetManagedDatabaseServiceType(exec.Context(), &request.GetManagedDatabaseServiceTypeRequest{Type: string(db.Type)})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		props, err := processProperties(s.properties, t)
+		if err == nil {
+			err = validateProperties(props, t)
+		}
+		if err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid properties: %w", err))
+		}
+		req.Properties = props
+	}
+
+	res, err := svc.ModifyManagedDatabase(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if s.wait.Value() {
+		WaitForManagedDatabaseState(res.UUID, upcloud.ManagedDatabaseStateRunning, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
+
+// validateProperties validates the given properties against the properties schema of the database type, so that typos and invalid values are caught before sending the request.
+func validateProperties(props request.ManagedDatabasePropertiesRequest, t *upcloud.ManagedDatabaseType) error {
+	for key, value := range props {
+		schema, ok := t.Properties[string(key)]
+		if !ok {
+			return fmt.Errorf("unknown property %q, run `upctl database properties %s` to list available properties", key, t.Name)
+		}
+
+		if schema.CreateOnly {
+			return fmt.Errorf("property %q can only be set when creating the database", key)
+		}
+
+		if err := validatePropertyValue(string(key), value, schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePropertyValue(key string, value any, schema upcloud.ManagedDatabaseServiceProperty) error {
+	allowedTypes := propertyTypes(schema.Type)
+	if valueType := jsonType(value); len(allowedTypes) > 0 && !slices.Contains(allowedTypes, valueType) {
+		// Integers are valid numbers
+		if valueType != "integer" || !slices.Contains(allowedTypes, "number") {
+			return fmt.Errorf("invalid value for property %q, expected %s", key, namedargs.ValidValuesHelp(allowedTypes...))
+		}
+	}
+
+	if enum, ok := schema.Enum.([]any); ok && len(enum) > 0 && !slices.Contains(enum, value) {
+		var values []string
+		for _, v := range enum {
+			values = append(values, fmt.Sprint(v))
+		}
+		return fmt.Errorf("invalid value %v for property %q, valid values are %s", value, key, namedargs.ValidValuesHelp(values...))
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if schema.Minimum != nil && v < *schema.Minimum {
+			return fmt.Errorf("invalid value %v for property %q, minimum is %v", v, key, *schema.Minimum)
+		}
+		if schema.Maximum != nil && v > *schema.Maximum {
+			return fmt.Errorf("invalid value %v for property %q, maximum is %v", v, key, *schema.Maximum)
+		}
+	case string:
+		if schema.MinLength > 0 && len(v) < schema.MinLength {
+			return fmt.Errorf("invalid value for property %q, minimum length is %d", key, schema.MinLength)
+		}
+		if schema.MaxLength > 0 && len(v) > schema.MaxLength {
+			return fmt.Errorf("invalid value for property %q, maximum length is %d", key, schema.MaxLength)
+		}
+	}
+
+	return nil
+}
+
+// propertyTypes returns the JSON schema types allowed by a property schema. The type can be either a single type or a list of types.
+func propertyTypes(typ any) []string {
+	switch v := typ.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		var types []string
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	default:
+		return nil
+	}
+}
+
+// jsonType returns the JSON schema type of a value parsed with processProperties.
+func jsonType(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return strings.ToLower(fmt.Sprintf("%T", v))
+	}
+}
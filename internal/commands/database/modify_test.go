@@ -0,0 +1,130 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestModifyCommand(t *testing.T) {
+	db := upcloud.ManagedDatabase{
+		UUID:  "0927dfd6-3884-4079-a948-3a8881df1a7a",
+		Title: "mydb",
+		Type:  upcloud.ManagedDatabaseServiceTypePostgreSQL,
+	}
+	minConnections, maxConnections := 25.0, 10000.0
+	serviceType := upcloud.ManagedDatabaseType{
+		Name: "pg",
+		Properties: map[string]upcloud.ManagedDatabaseServiceProperty{
+			"max_connections": {
+				Type:    "integer",
+				Minimum: &minConnections,
+				Maximum: &maxConnections,
+			},
+			"version": {
+				Type:       []string{"string", "null"},
+				CreateOnly: true,
+			},
+			"timezone": {
+				Type: "string",
+			},
+			"synchronous_replication": {
+				Type: "string",
+				Enum: []any{"quorum", "off"},
+			},
+		},
+	}
+	terminationProtection := false
+
+	for _, test := range []struct {
+		name  string
+		args  []string
+		req   request.ModifyManagedDatabaseRequest
+		error string
+	}{
+		{
+			name: "plan, title and labels",
+			args: []string{db.UUID, "--plan", "2x4xCPU-8GB-100GB", "--title", "my-renamed-db", "--label", "env=prod"},
+			req: request.ModifyManagedDatabaseRequest{
+				UUID:   db.UUID,
+				Plan:   "2x4xCPU-8GB-100GB",
+				Title:  "my-renamed-db",
+				Labels: &[]upcloud.Label{{Key: "env", Value: "prod"}},
+			},
+		},
+		{
+			name: "maintenance and termination protection",
+			args: []string{db.UUID, "--maintenance-dow", "sunday", "--maintenance-time", "02:00:00", "--disable-termination-protection"},
+			req: request.ModifyManagedDatabaseRequest{
+				UUID:                  db.UUID,
+				Maintenance:           request.ManagedDatabaseMaintenanceTimeRequest{DayOfWeek: "sunday", Time: "02:00:00"},
+				TerminationProtection: &terminationProtection,
+			},
+		},
+		{
+			name: "valid properties",
+			args: []string{db.UUID, "--property", "max_connections=300", "--property", "timezone=Europe/Helsinki", "--property", "synchronous_replication=quorum"},
+			req: request.ModifyManagedDatabaseRequest{
+				UUID: db.UUID,
+				Properties: request.ManagedDatabasePropertiesRequest{
+					"max_connections":         float64(300),
+					"timezone":                "Europe/Helsinki",
+					"synchronous_replication": "quorum",
+				},
+			},
+		},
+		{
+			name:  "unknown property",
+			args:  []string{db.UUID, "--property", "max_conections=300"},
+			error: "invalid properties: unknown property \"max_conections\", run `upctl database properties pg` to list available properties",
+		},
+		{
+			name:  "create only property",
+			args:  []string{db.UUID, "--property", "version=16"},
+			error: "invalid properties: property \"version\" can only be set when creating the database",
+		},
+		{
+			name:  "invalid type",
+			args:  []string{db.UUID, "--property", "max_connections=many"},
+			error: "invalid properties: invalid value for property \"max_connections\", expected `integer`",
+		},
+		{
+			name:  "below minimum",
+			args:  []string{db.UUID, "--property", "max_connections=10"},
+			error: "invalid properties: invalid value 10 for property \"max_connections\", minimum is 25",
+		},
+		{
+			name:  "invalid enum value",
+			args:  []string{db.UUID, "--property", "synchronous_replication=on"},
+			error: "invalid properties: invalid value on for property \"synchronous_replication\", valid values are `quorum` and `off`",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := smock.Service{}
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+			mService.On("GetManagedDatabaseServiceType", mock.Anything).Return(&serviceType, nil)
+			req := test.req
+			mService.On("ModifyManagedDatabase", &req).Return(&db, nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, "ModifyManagedDatabase", mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyManagedDatabase", 1)
+			}
+		})
+	}
+}
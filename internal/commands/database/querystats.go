@@ -0,0 +1,220 @@
+package database
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	queryStatsPageSize    = 500
+	queryStatsQueryMaxLen = 60
+
+	queryStatsSortTotalTime = "total-time"
+	queryStatsSortCalls     = "calls"
+	queryStatsSortRows      = "rows"
+)
+
+var queryStatsSortKeys = []string{queryStatsSortTotalTime, queryStatsSortCalls, queryStatsSortRows}
+
+// QueryStatsCommand creates the "database query-stats" command
+func QueryStatsCommand() commands.Command {
+	return &queryStatsCommand{
+		BaseCommand: commands.New(
+			"query-stats",
+			"Show query statistics of a PostgreSQL or MySQL database",
+			"upctl database query-stats my-pg-database",
+			"upctl database query-stats my-mysql-database --sort-by calls --limit 20",
+			"upctl database query-stats my-pg-database --output json",
+		),
+	}
+}
+
+type queryStatsCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+
+	limit  int
+	sortBy string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *queryStatsCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.IntVar(&c.limit, "limit", 10, "Number of queries to show.")
+	fs.StringVar(&c.sortBy, "sort-by", queryStatsSortTotalTime, "Sort the queries in descending order by the given statistic and show the top queries. Valid values are "+namedargs.ValidValuesHelp(queryStatsSortKeys...)+". Replaces the global `--sort-by` flag for this command.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("limit", cobra.NoFileCompletions))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("sort-by", cobra.FixedCompletions(queryStatsSortKeys, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *queryStatsCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	if c.limit < 1 {
+		return nil, fmt.Errorf("invalid --limit value %d, limit must be at least 1", c.limit)
+	}
+	if !slices.Contains(queryStatsSortKeys, c.sortBy) {
+		return nil, fmt.Errorf("invalid --sort-by value %s, valid values are %s", c.sortBy, namedargs.ValidValuesHelp(queryStatsSortKeys...))
+	}
+
+	svc := exec.All()
+	db, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	switch db.Type {
+	case upcloud.ManagedDatabaseServiceTypePostgreSQL:
+		stats, err := fetchQueryStatistics(exec.Context(), uuid, svc.GetManagedDatabaseQueryStatisticsPostgreSQL)
+		if err != nil {
+			return nil, err
+		}
+		return pgQueryStats(topQueries(stats, c.limit, func(s upcloud.ManagedDatabaseQueryStatisticsPostgreSQL) float64 {
+			return queryStatsSortValue(c.sortBy, s.TotalTime, s.Calls, s.Rows)
+		})), nil
+	case upcloud.ManagedDatabaseServiceTypeMySQL:
+		stats, err := fetchQueryStatistics(exec.Context(), uuid, svc.GetManagedDatabaseQueryStatisticsMySQL)
+		if err != nil {
+			return nil, err
+		}
+		return mysqlQueryStats(topQueries(stats, c.limit, func(s upcloud.ManagedDatabaseQueryStatisticsMySQL) float64 {
+			return queryStatsSortValue(c.sortBy, s.SumTimerWait, s.CountStar, s.SumRowsSent)
+		})), nil
+	default:
+		return nil, fmt.Errorf("query statistics not supported for database type %s", db.Type)
+	}
+}
+
+// fetchQueryStatistics fetches the statistics of all queries page by page, as the API does not support sorting the statistics.
+func fetchQueryStatistics[T any](ctx context.Context, uuid string, fetch func(context.Context, *request.GetManagedDatabaseQueryStatisticsRequest) ([]T, error)) ([]T, error) {
+	var stats []T
+	for offset := 0; ; offset += queryStatsPageSize {
+		page, err := fetch(ctx, &request.GetManagedDatabaseQueryStatisticsRequest{
+			UUID:   uuid,
+			Limit:  queryStatsPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, page...)
+		if len(page) < queryStatsPageSize {
+			return stats, nil
+		}
+	}
+}
+
+func queryStatsSortValue(sortBy string, totalTime time.Duration, calls, rows uint64) float64 {
+	switch sortBy {
+	case queryStatsSortCalls:
+		return float64(calls)
+	case queryStatsSortRows:
+		return float64(rows)
+	default:
+		return float64(totalTime)
+	}
+}
+
+func topQueries[T any](stats []T, limit int, value func(T) float64) []T {
+	stats = slices.Clone(stats)
+	slices.SortStableFunc(stats, func(a, b T) int {
+		return cmp.Compare(value(b), value(a))
+	})
+	if len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return stats
+}
+
+// formatQuery collapses the whitespace of the query and truncates it to fit into a table cell.
+func formatQuery(query string) string {
+	return ui.TruncateText(strings.Join(strings.Fields(query), " "), queryStatsQueryMaxLen)
+}
+
+func pgQueryStats(stats []upcloud.ManagedDatabaseQueryStatisticsPostgreSQL) output.Output {
+	rows := make([]output.TableRow, 0)
+
+	for _, s := range stats {
+		rows = append(rows, output.TableRow{
+			formatQuery(s.Query),
+			s.DatabaseName,
+			s.UserName,
+			s.Calls,
+			s.TotalTime,
+			s.MeanTime,
+			s.MaxTime,
+			s.Rows,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: stats,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "query", Header: "Query"},
+				{Key: "database_name", Header: "Database"},
+				{Key: "user_name", Header: "Username"},
+				{Key: "calls", Header: "Calls"},
+				{Key: "total_time", Header: "Total time", Format: format.Duration},
+				{Key: "mean_time", Header: "Mean time", Format: format.Duration},
+				{Key: "max_time", Header: "Max time", Format: format.Duration, Hidden: true},
+				{Key: "rows", Header: "Rows"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No query statistics found for this database.",
+		},
+	}
+}
+
+func mysqlQueryStats(stats []upcloud.ManagedDatabaseQueryStatisticsMySQL) output.Output {
+	rows := make([]output.TableRow, 0)
+
+	for _, s := range stats {
+		rows = append(rows, output.TableRow{
+			formatQuery(s.DigestText),
+			s.SchemaName,
+			s.CountStar,
+			s.SumTimerWait,
+			s.AvgTimerWait,
+			s.MaxTimerWait,
+			s.SumRowsSent,
+			s.SumRowsExamined,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: stats,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "query", Header: "Query"},
+				{Key: "schema_name", Header: "Schema"},
+				{Key: "calls", Header: "Calls"},
+				{Key: "total_time", Header: "Total time", Format: format.Duration},
+				{Key: "mean_time", Header: "Mean time", Format: format.Duration},
+				{Key: "max_time", Header: "Max time", Format: format.Duration, Hidden: true},
+				{Key: "rows", Header: "Rows"},
+				{Key: "rows_examined", Header: "Rows examined", Hidden: true},
+			},
+			Rows:         rows,
+			EmptyMessage: "No query statistics found for this database.",
+		},
+	}
+}
@@ -0,0 +1,154 @@
+package database
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const longQuery = "SELECT id, name, created_at, updated_at FROM customers\n  WHERE name LIKE $1 AND created_at BETWEEN $2 AND $3 ORDER BY created_at DESC"
+
+func TestQueryStatsCommand_PostgreSQL(t *testing.T) {
+	text.DisableColors()
+	db := upcloud.ManagedDatabase{UUID: "0927dfd6-3884-4079-a948-3a8881df1a7a", Type: upcloud.ManagedDatabaseServiceTypePostgreSQL}
+	stats := []upcloud.ManagedDatabaseQueryStatisticsPostgreSQL{
+		{Query: "SELECT 1", Calls: 1000, Rows: 1000, TotalTime: time.Second},
+		{Query: longQuery, Calls: 10, Rows: 5000, TotalTime: time.Minute},
+		{Query: "UPDATE customers SET name = $1", Calls: 50, Rows: 50, TotalTime: 10 * time.Second},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected []string
+		error    string
+	}{
+		{
+			name:     "sort by total time",
+			args:     []string{db.UUID},
+			expected: []string{"SELECT id, name", "UPDATE customers", "SELECT 1"},
+		},
+		{
+			name:     "sort by calls with limit",
+			args:     []string{db.UUID, "--sort-by", "calls", "--limit", "2"},
+			expected: []string{"SELECT 1", "UPDATE customers"},
+		},
+		{
+			name:     "sort by rows",
+			args:     []string{db.UUID, "--sort-by", "rows", "--limit", "1"},
+			expected: []string{"SELECT id, name"},
+		},
+		{
+			name:  "invalid sort key",
+			args:  []string{db.UUID, "--sort-by", "mean-time"},
+			error: "invalid --sort-by value mean-time",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := smock.Service{}
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+			mService.On("GetManagedDatabaseQueryStatisticsPostgreSQL", &request.GetManagedDatabaseQueryStatisticsRequest{
+				UUID:  db.UUID,
+				Limit: queryStatsPageSize,
+			}).Return(stats, nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(QueryStatsCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.ErrorContains(t, err, test.error)
+				mService.AssertNotCalled(t, "GetManagedDatabase", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotContains(t, out, longQuery)
+			lines := strings.Split(strings.TrimSpace(out), "\n")
+			// Skip the header and separator lines of the table
+			lines = lines[2:]
+			assert.Len(t, lines, len(test.expected))
+			for i, expected := range test.expected {
+				assert.Contains(t, lines[i], expected)
+			}
+		})
+	}
+}
+
+func TestQueryStatsCommand_SortColumnByDuration(t *testing.T) {
+	text.DisableColors()
+	db := upcloud.ManagedDatabase{UUID: "0927dfd6-3884-4079-a948-3a8881df1a7a", Type: upcloud.ManagedDatabaseServiceTypePostgreSQL}
+	stats := []upcloud.ManagedDatabaseQueryStatisticsPostgreSQL{
+		{Query: "SELECT 1", TotalTime: time.Second},
+		{Query: "SELECT 2", TotalTime: time.Minute},
+		{Query: "SELECT 3", TotalTime: 10 * time.Second},
+	}
+
+	mService := smock.Service{}
+	mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+	mService.On("GetManagedDatabaseQueryStatisticsPostgreSQL", mock.Anything).Return(stats, nil)
+
+	// Durations are sorted by their value, e.g. 10s before 1m0s, instead of lexically. The local --sort-by flag replaces the global flag, but the global sort key can still be set in the config.
+	conf := config.New()
+	conf.Viper().Set(config.KeySortBy, "total_time")
+	parent := &cobra.Command{Use: "database"}
+	c := commands.BuildCommand(QueryStatsCommand(), parent, conf)
+	parent.SetArgs([]string{"query-stats", db.UUID})
+	out, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")[2:]
+	assert.Len(t, lines, 3)
+	for i, expected := range []string{"SELECT 1", "SELECT 3", "SELECT 2"} {
+		assert.Contains(t, lines[i], expected)
+	}
+	assert.Contains(t, lines[2], "1m0s")
+}
+
+func TestQueryStatsCommand_MySQLJSON(t *testing.T) {
+	db := upcloud.ManagedDatabase{UUID: "0927dfd6-3884-4079-a948-3a8881df1a7a", Type: upcloud.ManagedDatabaseServiceTypeMySQL}
+	stats := []upcloud.ManagedDatabaseQueryStatisticsMySQL{
+		{DigestText: "SELECT ?", CountStar: 1000, SumTimerWait: time.Second},
+		{DigestText: longQuery, CountStar: 10, SumTimerWait: time.Minute},
+	}
+
+	mService := smock.Service{}
+	mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+	mService.On("GetManagedDatabaseQueryStatisticsMySQL", mock.Anything).Return(stats, nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(QueryStatsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{db.UUID, "--limit", "1"})
+	out, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.NoError(t, err)
+	assert.Contains(t, out, `"digest_text": "SELECT id, name, created_at, updated_at FROM customers\n  WHERE name LIKE $1 AND created_at BETWEEN $2 AND $3 ORDER BY created_at DESC"`)
+	assert.NotContains(t, out, `"digest_text": "SELECT ?"`)
+}
+
+func TestQueryStatsCommand_UnsupportedType(t *testing.T) {
+	db := upcloud.ManagedDatabase{UUID: "0927dfd6-3884-4079-a948-3a8881df1a7a", Type: upcloud.ManagedDatabaseServiceTypeValkey}
+
+	mService := smock.Service{}
+	mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+
+	conf := config.New()
+	c := commands.BuildCommand(QueryStatsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{db.UUID})
+	_, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.EqualError(t, err, "query statistics not supported for database type valkey")
+}
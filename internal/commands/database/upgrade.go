@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// UpgradeCommand creates the "database upgrade" command
+func UpgradeCommand() commands.Command {
+	return &upgradeCommand{
+		BaseCommand: commands.New(
+			"upgrade",
+			"Upgrade database to a newer major version",
+			"upctl database upgrade my-pg-database --version 16",
+			"upctl database upgrade my-pg-database --version 16 --clone --wait",
+			"upctl database upgrade my-pg-database --version 16 --yes",
+		),
+	}
+}
+
+type upgradeCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+
+	version             string
+	clone               config.OptionalBoolean
+	cloneHostnamePrefix string
+	wait                config.OptionalBoolean
+	cfg                 *config.Config
+}
+
+// InitCommand implements Command.InitCommand
+func (c *upgradeCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.version, "version", "", "Target version. Run `upctl database versions <database>` to list the available versions.")
+	config.AddToggleFlag(fs, &c.clone, "clone", false, "Clone the database from its latest backup and wait for the clone to be running before upgrading, to keep a copy of the data in the current version.")
+	fs.StringVar(&c.cloneHostnamePrefix, "clone-hostname-prefix", "", "Hostname prefix of the clone. Defaults to the name of the database followed by `-pre-upgrade`.")
+	config.AddToggleFlag(fs, &c.wait, "wait", false, "Wait for database to be in running state after the upgrade has been started before returning. Ignored in dry-run mode.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("version"))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *upgradeCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.cfg = cfg
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("version", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingDatabase{}, cfg, upgradeVersions)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("clone-hostname-prefix", cobra.NoFileCompletions))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *upgradeCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	db, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	if current := getVersion(db); current == c.version {
+		return nil, fmt.Errorf("database %s is already at version %s", uuid, current)
+	}
+
+	versions, err := svc.GetManagedDatabaseVersions(exec.Context(), &request.GetManagedDatabaseVersionsRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(versions, c.version) {
+		if len(versions) == 0 {
+			return nil, fmt.Errorf("no versions available for database %s", uuid)
+		}
+		return nil, fmt.Errorf("invalid --version value %s, valid values are %s", c.version, namedargs.ValidValuesHelp(versions...))
+	}
+
+	if err := commands.ConfirmOperation(c, exec.Config(), fmt.Sprintf("upgraded to version %s, which can not be reverted", c.version), "Upgrade", []commands.ConfirmResource{
+		{Type: "database", UUID: uuid, Name: db.Title},
+	}); err != nil {
+		return nil, err
+	}
+
+	if c.clone.Value() {
+		if err := c.cloneDatabase(exec, db); err != nil {
+			return nil, err
+		}
+	}
+
+	msg := fmt.Sprintf("Upgrading database %s to version %s", uuid, c.version)
+	exec.PushProgressStarted(msg)
+
+	res, err := svc.UpgradeManagedDatabaseVersion(exec.Context(), &request.UpgradeManagedDatabaseVersionRequest{
+		UUID:          uuid,
+		TargetVersion: c.version,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	// In dry-run mode, the upgrade is not started and there is nothing to wait for.
+	if c.wait.Value() && !c.cfg.DryRun() {
+		if err := waitForState(uuid, upcloud.ManagedDatabaseStateRunning, exec, msg); err != nil {
+			exec.PushProgressUpdate(messages.Update{
+				Key:     msg,
+				Message: msg,
+				Status:  messages.MessageStatusWarning,
+				Details: "Error: " + err.Error(),
+			})
+		} else {
+			exec.PushProgressUpdateMessage(msg, msg)
+			exec.PushProgressSuccess(msg)
+		}
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
+
+// upgradeVersions returns the versions the given database can be upgraded to. Use with namedargs.ResolvedArgumentCompletionFunc to complete versions.
+func upgradeVersions(ctx context.Context, svc service.AllServices, uuid string) ([]string, error) {
+	return svc.GetManagedDatabaseVersions(ctx, &request.GetManagedDatabaseVersionsRequest{UUID: uuid})
+}
+
+func (c *upgradeCommand) cloneDatabase(exec commands.Executor, db *upcloud.ManagedDatabase) error {
+	hostnamePrefix := c.cloneHostnamePrefix
+	if hostnamePrefix == "" {
+		hostnamePrefix = db.Name + "-pre-upgrade"
+	}
+
+	msg := fmt.Sprintf("Cloning database %s to %s before upgrade", db.UUID, hostnamePrefix)
+	exec.PushProgressStarted(msg)
+
+	clone, err := exec.All().CloneManagedDatabase(exec.Context(), &request.CloneManagedDatabaseRequest{
+		UUID:           db.UUID,
+		HostNamePrefix: hostnamePrefix,
+		Plan:           db.Plan,
+		Title:          fmt.Sprintf("%s (before upgrade to %s)", db.Title, c.version),
+		Zone:           db.Zone,
+	})
+	if err != nil {
+		_, err = commands.HandleError(exec, msg, err)
+		return err
+	}
+
+	if err := waitForState(clone.UUID, upcloud.ManagedDatabaseStateRunning, exec, msg); err != nil {
+		_, err = commands.HandleError(exec, msg, fmt.Errorf("clone %s did not reach running state: %w", clone.UUID, err))
+		return err
+	}
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	exec.PushProgressSuccess(msg)
+	return nil
+}
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpgradeCommand(t *testing.T) {
+	db := upcloud.ManagedDatabase{
+		UUID:     "0927dfd6-3884-4079-a948-3a8881df1a7a",
+		Name:     "pg-1",
+		Title:    "mydb",
+		Plan:     "1x1xCPU-2GB-25GB",
+		Zone:     "fi-hel1",
+		Type:     upcloud.ManagedDatabaseServiceTypePostgreSQL,
+		Metadata: &upcloud.ManagedDatabaseMetadata{PGVersion: "15"},
+		State:    upcloud.ManagedDatabaseStateRunning,
+	}
+	clone := upcloud.ManagedDatabase{UUID: "09c1ad7a-4de3-4f5c-a1b4-6e4b5a7f2e10"}
+
+	for _, test := range []struct {
+		name    string
+		args    []string
+		clone   *request.CloneManagedDatabaseRequest
+		wait    bool
+		dryRun  bool
+		upgrade bool
+		error   string
+	}{
+		{
+			name:    "upgrade",
+			args:    []string{db.UUID, "--version", "16"},
+			upgrade: true,
+		},
+		{
+			name: "clone and upgrade with wait",
+			args: []string{db.UUID, "--version", "17", "--clone", "--wait"},
+			clone: &request.CloneManagedDatabaseRequest{
+				UUID:           db.UUID,
+				HostNamePrefix: "pg-1-pre-upgrade",
+				Plan:           db.Plan,
+				Title:          "mydb (before upgrade to 17)",
+				Zone:           db.Zone,
+			},
+			wait:    true,
+			upgrade: true,
+		},
+		{
+			name:    "upgrade with wait in dry-run mode",
+			args:    []string{db.UUID, "--version", "17", "--wait"},
+			dryRun:  true,
+			upgrade: true,
+		},
+		{
+			name: "clone with hostname prefix",
+			args: []string{db.UUID, "--version", "16", "--clone", "--clone-hostname-prefix", "pg-backup"},
+			clone: &request.CloneManagedDatabaseRequest{
+				UUID:           db.UUID,
+				HostNamePrefix: "pg-backup",
+				Plan:           db.Plan,
+				Title:          "mydb (before upgrade to 16)",
+				Zone:           db.Zone,
+			},
+			upgrade: true,
+		},
+		{
+			name:  "unavailable version",
+			args:  []string{db.UUID, "--version", "18", "--clone"},
+			error: "invalid --version value 18, valid values are `16` and `17`",
+		},
+		{
+			name:  "current version",
+			args:  []string{db.UUID, "--version", "15"},
+			error: "database 0927dfd6-3884-4079-a948-3a8881df1a7a is already at version 15",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := smock.Service{}
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+			mService.On("GetManagedDatabaseVersions", &request.GetManagedDatabaseVersionsRequest{UUID: db.UUID}).Return([]string{"16", "17"}, nil)
+			mService.On("CloneManagedDatabase", mock.Anything).Return(&clone, nil)
+			mService.On("UpgradeManagedDatabaseVersion", mock.Anything).Return(&db, nil)
+			mService.On("WaitForManagedDatabaseState", mock.Anything).Return(&db, nil)
+
+			conf := config.New()
+			conf.Viper().Set(config.KeyDryRun, test.dryRun)
+			conf.Viper().Set(config.KeyYes, true)
+			c := commands.BuildCommand(UpgradeCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.clone != nil {
+				mService.AssertCalled(t, "CloneManagedDatabase", test.clone)
+				mService.AssertCalled(t, "WaitForManagedDatabaseState", &request.WaitForManagedDatabaseStateRequest{
+					UUID:         clone.UUID,
+					DesiredState: upcloud.ManagedDatabaseStateRunning,
+				})
+			} else {
+				mService.AssertNotCalled(t, "CloneManagedDatabase", mock.Anything)
+			}
+
+			if test.upgrade {
+				mService.AssertCalled(t, "UpgradeManagedDatabaseVersion", &request.UpgradeManagedDatabaseVersionRequest{
+					UUID:          db.UUID,
+					TargetVersion: test.args[2],
+				})
+			} else {
+				mService.AssertNotCalled(t, "UpgradeManagedDatabaseVersion", mock.Anything)
+			}
+
+			waitRequest := &request.WaitForManagedDatabaseStateRequest{
+				UUID:         db.UUID,
+				DesiredState: upcloud.ManagedDatabaseStateRunning,
+			}
+			if test.wait {
+				mService.AssertCalled(t, "WaitForManagedDatabaseState", waitRequest)
+			} else {
+				mService.AssertNotCalled(t, "WaitForManagedDatabaseState", waitRequest)
+			}
+		})
+	}
+}
+
+func TestUpgradeCommand_NotConfirmed(t *testing.T) {
+	db := upcloud.ManagedDatabase{
+		UUID:     "0927dfd6-3884-4079-a948-3a8881df1a7a",
+		Title:    "mydb",
+		Type:     upcloud.ManagedDatabaseServiceTypePostgreSQL,
+		Metadata: &upcloud.ManagedDatabaseMetadata{PGVersion: "15"},
+	}
+
+	mService := smock.Service{}
+	mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+	mService.On("GetManagedDatabaseVersions", &request.GetManagedDatabaseVersionsRequest{UUID: db.UUID}).Return([]string{"16", "17"}, nil)
+
+	conf := config.New()
+	c := commands.BuildCommand(UpgradeCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{db.UUID, "--version", "16", "--clone"})
+	_, err := mockexecute.MockExecute(c, &mService, conf)
+
+	assert.EqualError(t, err, "confirming the operation requires an interactive terminal. Use --yes flag to confirm the operation in non-interactive mode")
+	mService.AssertNotCalled(t, "CloneManagedDatabase", mock.Anything)
+	mService.AssertNotCalled(t, "UpgradeManagedDatabaseVersion", mock.Anything)
+}
@@ -0,0 +1,60 @@
+package database
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// VersionsCommand creates the "database versions" command
+func VersionsCommand() commands.Command {
+	return &versionsCommand{
+		BaseCommand: commands.New(
+			"versions",
+			"List versions available for a database",
+			"upctl database versions my-pg-database",
+		),
+	}
+}
+
+type versionsCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *versionsCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	db, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := svc.GetManagedDatabaseVersions(exec.Context(), &request.GetManagedDatabaseVersionsRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	current := getVersion(db)
+	rows := []output.TableRow{}
+	for _, version := range versions {
+		rows = append(rows, output.TableRow{version, version == current})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: versions,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "version", Header: "Version"},
+				{Key: "current", Header: "Current", Format: format.Boolean},
+			},
+			Rows:         rows,
+			EmptyMessage: "No versions available for this database.",
+		},
+	}, nil
+}
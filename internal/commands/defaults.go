@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// FlagConfigKey returns the config key that can be used to define a default value for the named flag of the command.
+// The key is the command path without the root command followed by the flag name, e.g. server.create.zone.
+func FlagConfigKey(cmd *cobra.Command, flagName string) string {
+	path := []string{flagName}
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		path = append([]string{c.Name()}, path...)
+	}
+	return strings.Join(path, ".")
+}
+
+// setHelpDefaultsFromConfig updates the defaults shown in the help of the command to match the values defined in the config.
+func setHelpDefaultsFromConfig(cmd *cobra.Command, cfg *config.Config) {
+	cmd.LocalNonPersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		value, ok := flagDefaultFromConfig(cmd, cfg, flag)
+		if !ok {
+			return
+		}
+
+		if values, ok := toStringSlice(value); ok {
+			flag.DefValue = "[" + strings.Join(values, ",") + "]"
+			return
+		}
+		flag.DefValue = fmt.Sprint(value)
+	})
+}
+
+// cobraMutuallyExclusiveAnnotation is the annotation cobra uses to store the mutually exclusive flag groups of a flag.
+const cobraMutuallyExclusiveAnnotation = "cobra_annotation_mutually_exclusive"
+
+// flagAnnotationFromConfig marks flags whose value was set from config.
+const flagAnnotationFromConfig = "upctl_flag_from_config"
+
+// setFlagDefaultsFromConfig sets values defined in config to the flags of the command that were not given on the command line. The flags are not marked as changed here, so that flag groups are validated against the command line only, see MarkConfigDefaultsChanged.
+func setFlagDefaultsFromConfig(cmd *cobra.Command, cfg *config.Config) error {
+	var err error
+	cmd.LocalNonPersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		if err != nil || flag.Changed || exclusiveFlagChanged(cmd, flag) {
+			return
+		}
+
+		value, ok := flagDefaultFromConfig(cmd, cfg, flag)
+		if !ok {
+			return
+		}
+
+		if err = setFlagValue(flag, value); err != nil {
+			err = fmt.Errorf("invalid value for %s in config: %w", FlagConfigKey(cmd, flag.Name), err)
+			return
+		}
+
+		// Value from config satisfies the requirement of a required flag.
+		delete(flag.Annotations, cobra.BashCompOneRequiredFlag)
+		Must(cmd.Flags().SetAnnotation(flag.Name, flagAnnotationFromConfig, []string{"true"}))
+	})
+	return err
+}
+
+// MarkConfigDefaultsChanged marks the flags that got their value from config as changed, so that commands that check whether a flag was set use the value from config. This must be done after flag groups have been validated.
+func MarkConfigDefaultsChanged(cmd *cobra.Command) {
+	cmd.LocalNonPersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		if _, ok := flag.Annotations[flagAnnotationFromConfig]; ok {
+			flag.Changed = true
+		}
+	})
+}
+
+// exclusiveFlagChanged checks if a flag that is mutually exclusive with the given flag was given on the command line. Value from config is not used in that case.
+func exclusiveFlagChanged(cmd *cobra.Command, flag *pflag.Flag) bool {
+	for _, group := range flag.Annotations[cobraMutuallyExclusiveAnnotation] {
+		for _, name := range strings.Split(group, " ") {
+			if other := cmd.Flags().Lookup(name); other != nil && other != flag && other.Changed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// flagDefaultFromConfig returns the value defined in config for the flag. The top-level zone is used as a fallback for commands that require a zone.
+func flagDefaultFromConfig(cmd *cobra.Command, cfg *config.Config, flag *pflag.Flag) (any, bool) {
+	if key := FlagConfigKey(cmd, flag.Name); cfg.IsSet(key) {
+		value := cfg.Get(key)
+		if _, ok := value.(map[string]any); ok {
+			return nil, false
+		}
+		return value, true
+	}
+
+	if _, required := flag.Annotations[cobra.BashCompOneRequiredFlag]; flag.Name == "zone" && required {
+		if zone := cfg.GetString(config.KeyZone); zone != "" {
+			return zone, true
+		}
+	}
+
+	return nil, false
+}
+
+func setFlagValue(flag *pflag.Flag, value any) error {
+	values, isList := toStringSlice(value)
+	sliceValue, isSlice := flag.Value.(pflag.SliceValue)
+
+	if isList && isSlice {
+		return sliceValue.Replace(values)
+	}
+
+	if isList {
+		return fmt.Errorf("expected a single value, got a list")
+	}
+	return flag.Value.Set(fmt.Sprint(value))
+}
+
+func toStringSlice(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []any:
+		strs := make([]string, len(v))
+		for i, item := range v {
+			strs[i] = fmt.Sprint(item)
+		}
+		return strs, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildDefaultsTestCommand() (*cobra.Command, *cobra.Command) {
+	root := &cobra.Command{Use: "upctl"}
+	server := &cobra.Command{Use: "server"}
+	create := &cobra.Command{Use: "create"}
+	create.Flags().String("zone", "", "")
+	create.Flags().String("plan", "1xCPU-1GB", "")
+	create.Flags().StringSlice("ssh-keys", nil, "")
+	create.Flags().Bool("wait", false, "")
+	Must(create.MarkFlagRequired("zone"))
+
+	root.AddCommand(server)
+	server.AddCommand(create)
+	return root, create
+}
+
+func TestFlagConfigKey(t *testing.T) {
+	_, create := buildDefaultsTestCommand()
+	assert.Equal(t, "server.create.ssh-keys", FlagConfigKey(create, "ssh-keys"))
+}
+
+func TestSetFlagDefaultsFromConfig(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		config   map[string]any
+		args     []string
+		expected map[string]string
+	}{
+		{
+			name: "command specific defaults",
+			config: map[string]any{
+				"server.create.zone":     "fi-hel1",
+				"server.create.ssh-keys": []any{"key-a", "key-b"},
+				"server.create.wait":     true,
+			},
+			expected: map[string]string{
+				"zone":     "fi-hel1",
+				"plan":     "1xCPU-1GB",
+				"ssh-keys": "[key-a,key-b]",
+				"wait":     "true",
+			},
+		},
+		{
+			name: "command line flags take precedence",
+			config: map[string]any{
+				"server.create.zone": "fi-hel1",
+				"server.create.plan": "2xCPU-4GB",
+			},
+			args: []string{"--zone", "de-fra1"},
+			expected: map[string]string{
+				"zone": "de-fra1",
+				"plan": "2xCPU-4GB",
+			},
+		},
+		{
+			name: "top-level zone is used for required zone",
+			config: map[string]any{
+				"zone": "pl-waw1",
+			},
+			expected: map[string]string{
+				"zone": "pl-waw1",
+			},
+		},
+		{
+			name: "command specific zone takes precedence over top-level zone",
+			config: map[string]any{
+				"zone":               "pl-waw1",
+				"server.create.zone": "fi-hel1",
+			},
+			expected: map[string]string{
+				"zone": "fi-hel1",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, create := buildDefaultsTestCommand()
+			cfg := config.New()
+			for key, value := range test.config {
+				cfg.Viper().Set(key, value)
+			}
+
+			require.NoError(t, create.ParseFlags(test.args))
+			require.NoError(t, setFlagDefaultsFromConfig(create, cfg))
+			assert.NoError(t, create.ValidateRequiredFlags())
+
+			for name, expected := range test.expected {
+				assert.Equal(t, expected, create.Flags().Lookup(name).Value.String())
+			}
+		})
+	}
+}
+
+func TestSetFlagDefaultsFromConfig_NotChanged(t *testing.T) {
+	_, create := buildDefaultsTestCommand()
+	create.Flags().Bool("no-wait", false, "")
+	create.MarkFlagsMutuallyExclusive("wait", "no-wait")
+
+	cfg := config.New()
+	cfg.Viper().Set("zone", "pl-waw1")
+	cfg.Viper().Set("server.create.plan", "2xCPU-4GB")
+	cfg.Viper().Set("server.create.wait", true)
+
+	require.NoError(t, create.ParseFlags([]string{"--no-wait"}))
+	require.NoError(t, setFlagDefaultsFromConfig(create, cfg))
+	assert.NoError(t, create.ValidateRequiredFlags())
+	assert.NoError(t, create.ValidateFlagGroups())
+
+	// Value from config is not used, if a mutually exclusive flag was given on the command line.
+	assert.Equal(t, "false", create.Flags().Lookup("wait").Value.String())
+	assert.Equal(t, "2xCPU-4GB", create.Flags().Lookup("plan").Value.String())
+	assert.False(t, create.Flags().Changed("plan"))
+	assert.False(t, create.Flags().Changed("zone"))
+
+	MarkConfigDefaultsChanged(create)
+	assert.True(t, create.Flags().Changed("plan"))
+	assert.True(t, create.Flags().Changed("zone"))
+	assert.False(t, create.Flags().Changed("wait"))
+	assert.NoError(t, create.ValidateFlagGroups())
+}
+
+func TestSetFlagDefaultsFromConfig_InvalidValue(t *testing.T) {
+	_, create := buildDefaultsTestCommand()
+	cfg := config.New()
+	cfg.Viper().Set("server.create.wait", "maybe")
+
+	err := setFlagDefaultsFromConfig(create, cfg)
+	assert.ErrorContains(t, err, "invalid value for server.create.wait in config")
+}
+
+func TestSetHelpDefaultsFromConfig(t *testing.T) {
+	_, create := buildDefaultsTestCommand()
+	cfg := config.New()
+	cfg.Viper().Set("server.create.zone", "fi-hel1")
+	cfg.Viper().Set("server.create.ssh-keys", []any{"key-a", "key-b"})
+
+	setHelpDefaultsFromConfig(create, cfg)
+	assert.Equal(t, "fi-hel1", create.Flags().Lookup("zone").DefValue)
+	assert.Equal(t, "[key-a,key-b]", create.Flags().Lookup("ssh-keys").DefValue)
+	assert.Equal(t, "1xCPU-1GB", create.Flags().Lookup("plan").DefValue)
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+)
+
+// secretKeys are the keys of request fields that contain secrets, e.g., passwords, pre-shared keys, and private keys. Fields with these keys, or keys ending with `_` and one of these, are redacted from the dry-run output.
+var secretKeys = []string{"password", "psk", "private_key", "secret", "token"}
+
+const redactedValue = "[redacted]"
+
+// dryRunOutputs replaces the outputs of the command with the API calls recorded in dry-run mode. Errors are kept, so that the command fails as it would without dry-run mode.
+func dryRunOutputs(dryRun *internal.DryRun, outputs []output.Output) []output.Output {
+	if dryRun == nil {
+		return outputs
+	}
+
+	calls := dryRun.Calls()
+	rows := make([]output.TableRow, 0, len(calls))
+	for i, call := range calls {
+		calls[i].Request = redactRequest(call.Request)
+		request, err := json.Marshal(calls[i].Request)
+		if err != nil {
+			request = []byte(err.Error())
+		}
+		rows = append(rows, output.TableRow{i + 1, call.Method, call.URL, string(request)})
+	}
+
+	result := []output.Output{output.MarshaledWithHumanOutput{
+		Value: calls,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "index", Header: "#"},
+				{Key: "method", Header: "Method"},
+				{Key: "url", Header: "URL"},
+				{Key: "request", Header: "Request"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No changes would be made.",
+		},
+	}}
+	for _, o := range outputs {
+		switch o.(type) {
+		case output.Error, output.Failure:
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// redactRequest returns the request as a generic JSON value with the values of secret fields replaced, so that secrets are not printed in dry-run mode. If the request can not be marshaled, it is returned as is and marshaling fails when rendering the output.
+func redactRequest(request any) any {
+	b, err := json.Marshal(request)
+	if err != nil {
+		return request
+	}
+	var value any
+	if err := json.Unmarshal(b, &value); err != nil {
+		return request
+	}
+	return redactSecrets(value)
+}
+
+func redactSecrets(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isSecretKey(key) && item != nil && item != "" {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactSecrets(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactSecrets(item)
+		}
+	}
+	return value
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range secretKeys {
+		if key == secret || strings.HasSuffix(key, "_"+secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -35,6 +35,7 @@ type Executor interface {
 	Account() service.Account
 	Token() service.Token
 	All() internal.AllServices
+	Config() *config.Config
 	Debug(msg string, args ...any)
 	WithLogger(args ...any) Executor
 	WithProgress(progress *progress.Progress) Executor
@@ -48,7 +49,7 @@ type executeResult struct {
 }
 
 type executorImpl struct {
-	Config     *config.Config
+	config     *config.Config
 	progress   *progress.Progress
 	service    internal.AllServices
 	logger     *slog.Logger
@@ -66,7 +67,11 @@ func (e executorImpl) WithProgress(progress *progress.Progress) Executor {
 }
 
 func (e *executorImpl) Context() context.Context {
-	return e.Config.Context()
+	return e.config.Context()
+}
+
+func (e *executorImpl) Config() *config.Config {
+	return e.config
 }
 
 func (e *executorImpl) Debug(msg string, args ...any) {
@@ -155,7 +160,7 @@ func (e executorImpl) All() internal.AllServices {
 // NewExecutor creates the default Executor
 func NewExecutor(cfg *config.Config, svc internal.AllServices, logger *slog.Logger) Executor {
 	executor := &executorImpl{
-		Config:     cfg,
+		config:     cfg,
 		progress:   progress.NewProgress(config.GetProgressOutputConfig(cfg)),
 		logger:     logger,
 		service:    svc,
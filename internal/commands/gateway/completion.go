@@ -0,0 +1,37 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ConnectionNames returns the names of the connections of the given gateway. Use with namedargs.ResolvedArgumentCompletionFunc to complete connection names.
+func ConnectionNames(ctx context.Context, svc service.AllServices, uuid string) ([]string, error) {
+	connections, err := svc.GetGatewayConnections(ctx, &request.GetGatewayConnectionsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, connection := range connections {
+		names = append(names, connection.Name)
+	}
+	return names, nil
+}
+
+// AddressNames returns the names of the addresses of the given gateway. Use with namedargs.ResolvedArgumentCompletionFunc to complete local addresses of VPN tunnels.
+func AddressNames(ctx context.Context, svc service.AllServices, uuid string) ([]string, error) {
+	gtw, err := svc.GetGateway(ctx, &request.GetGatewayRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, address := range gtw.Addresses {
+		names = append(names, address.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,87 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// BaseConnectionCommand creates the base "gateway connection" command
+func BaseConnectionCommand() commands.Command {
+	return &connectionCommand{
+		commands.New("connection", "Manage gateway VPN connections"),
+	}
+}
+
+type connectionCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *connectionCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"connections"}
+}
+
+// ResolveConnection finds a connection of the given gateway by name or UUID.
+func ResolveConnection(exec commands.Executor, gatewayUUID, arg string) (upcloud.GatewayConnection, error) {
+	connections, err := exec.All().GetGatewayConnections(exec.Context(), &request.GetGatewayConnectionsRequest{ServiceUUID: gatewayUUID})
+	if err != nil {
+		return upcloud.GatewayConnection{}, err
+	}
+
+	var matches []upcloud.GatewayConnection
+	for _, connection := range connections {
+		if connection.UUID == arg || connection.Name == arg {
+			matches = append(matches, connection)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return upcloud.GatewayConnection{}, fmt.Errorf("connection %q not found in gateway %s", arg, gatewayUUID)
+	case 1:
+		return matches[0], nil
+	default:
+		return upcloud.GatewayConnection{}, fmt.Errorf("connection name %q is ambiguous in gateway %s, use UUID instead", arg, gatewayUUID)
+	}
+}
+
+// parseRoute parses a static route from `--local-route` or `--remote-route` flag value.
+func parseRoute(in string) (upcloud.GatewayRoute, error) {
+	route := upcloud.GatewayRoute{Type: upcloud.GatewayRouteTypeStatic}
+	args, err := commands.Parse(in)
+	if err != nil {
+		return route, err
+	}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&route.Name, "name", "", "")
+	fs.StringVar(&route.StaticNetwork, "static-network", "", "")
+	if err := fs.Parse(args); err != nil {
+		return route, err
+	}
+
+	if route.Name == "" || route.StaticNetwork == "" {
+		return route, fmt.Errorf("invalid route %q, both name and static-network are required", in)
+	}
+
+	return route, nil
+}
+
+func parseRoutes(in []string) ([]upcloud.GatewayRoute, error) {
+	var routes []upcloud.GatewayRoute
+	for _, v := range in {
+		route, err := parseRoute(v)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+const routeUsage = "Usage: `name=office,static-network=10.0.0.0/24`"
@@ -0,0 +1,93 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var connectionTypes = []string{
+	string(upcloud.GatewayConnectionTypeIPSec),
+}
+
+// CreateCommand creates the "gateway connection create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a VPN connection to a gateway",
+			`upctl gateway connection create my-gateway \
+				--name to-office \
+				--local-route name=cloud,static-network=10.0.0.0/24 \
+				--remote-route name=office,static-network=192.168.1.0/24`,
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	name         string
+	typ          string
+	localRoutes  []string
+	remoteRoutes []string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Connection name.")
+	fs.StringVar(&c.typ, "type", string(upcloud.GatewayConnectionTypeIPSec), "Connection type. Valid values are "+namedargs.ValidValuesHelp(connectionTypes...)+".")
+	fs.StringArrayVar(&c.localRoutes, "local-route", nil, "Static route to the network behind the gateway, multiple can be declared.\n"+routeUsage)
+	fs.StringArrayVar(&c.remoteRoutes, "remote-route", nil, "Static route to the network behind the remote peer, multiple can be declared.\n"+routeUsage)
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	for _, flag := range []string{"name", "local-route", "remote-route"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("type", cobra.FixedCompletions(connectionTypes, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *createCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	localRoutes, err := parseRoutes(c.localRoutes)
+	if err != nil {
+		return nil, err
+	}
+	remoteRoutes, err := parseRoutes(c.remoteRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating connection %s to gateway %s", c.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateGatewayConnection(exec.Context(), &request.CreateGatewayConnectionRequest{
+		ServiceUUID: uuid,
+		Connection: request.GatewayConnection{
+			Name:         c.name,
+			Type:         upcloud.GatewayConnectionType(c.typ),
+			LocalRoutes:  localRoutes,
+			RemoteRoutes: remoteRoutes,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
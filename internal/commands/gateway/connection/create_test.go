@@ -0,0 +1,73 @@
+package gatewayconnection
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	gatewayUUID := "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateGatewayConnectionRequest
+		errorMsg string
+	}{
+		{
+			name:     "invalid route",
+			args:     []string{gatewayUUID, "--name", "to-office", "--local-route", "name=cloud"},
+			errorMsg: `invalid route "name=cloud", both name and static-network are required`,
+		},
+		{
+			name: "with routes",
+			args: []string{
+				gatewayUUID,
+				"--name", "to-office",
+				"--local-route", "name=cloud,static-network=10.0.0.0/24",
+				"--remote-route", "name=office,static-network=192.168.1.0/24",
+			},
+			expected: request.CreateGatewayConnectionRequest{
+				ServiceUUID: gatewayUUID,
+				Connection: request.GatewayConnection{
+					Name: "to-office",
+					Type: upcloud.GatewayConnectionTypeIPSec,
+					LocalRoutes: []upcloud.GatewayRoute{
+						{Name: "cloud", StaticNetwork: "10.0.0.0/24", Type: upcloud.GatewayRouteTypeStatic},
+					},
+					RemoteRoutes: []upcloud.GatewayRoute{
+						{Name: "office", StaticNetwork: "192.168.1.0/24", Type: upcloud.GatewayRouteTypeStatic},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("CreateGatewayConnection", &expected).Return(&upcloud.GatewayConnection{Name: "to-office"}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateGatewayConnection", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// DeleteCommand creates the "gateway connection delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a VPN connection from a gateway",
+			"upctl gateway connection delete my-gateway --connection to-office",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *deleteCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.connection, "connection", "", "Name or UUID of the connection.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("connection"))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("connection", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.ConnectionNames)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *deleteCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	connection, err := ResolveConnection(exec, uuid, c.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Deleting connection %s of gateway %s", connection.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	err = exec.All().DeleteGatewayConnection(exec.Context(), &request.DeleteGatewayConnectionRequest{
+		ServiceUUID: uuid,
+		UUID:        connection.UUID,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
@@ -0,0 +1,97 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "gateway connection modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a VPN connection of a gateway",
+			`upctl gateway connection modify my-gateway \
+				--connection to-office \
+				--remote-route name=office,static-network=192.168.1.0/24 \
+				--remote-route name=office-guest,static-network=192.168.2.0/24`,
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection   string
+	localRoutes  []string
+	remoteRoutes []string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.connection, "connection", "", "Name or UUID of the connection.")
+	fs.StringArrayVar(&c.localRoutes, "local-route", nil, "Static route to the network behind the gateway, multiple can be declared. Replaces the existing local routes.\n"+routeUsage)
+	fs.StringArrayVar(&c.remoteRoutes, "remote-route", nil, "Static route to the network behind the remote peer, multiple can be declared. Replaces the existing remote routes.\n"+routeUsage)
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("connection"))
+	c.Cobra().MarkFlagsOneRequired("local-route", "remote-route")
+	for _, flag := range []string{"local-route", "remote-route"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("connection", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.ConnectionNames)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	localRoutes, err := parseRoutes(c.localRoutes)
+	if err != nil {
+		return nil, err
+	}
+	remoteRoutes, err := parseRoutes(c.remoteRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := ResolveConnection(exec, uuid, c.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Modifying connection %s of gateway %s", connection.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyGatewayConnection(exec.Context(), &request.ModifyGatewayConnectionRequest{
+		ServiceUUID: uuid,
+		UUID:        connection.UUID,
+		Connection: request.ModifyGatewayConnection{
+			LocalRoutes:  localRoutes,
+			RemoteRoutes: remoteRoutes,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
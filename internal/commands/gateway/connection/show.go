@@ -0,0 +1,133 @@
+package gatewayconnection
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+// ShowCommand creates the "gateway connection show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show VPN connection details",
+			"upctl gateway connection show my-gateway --connection to-office",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *showCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.connection, "connection", "", "Name or UUID of the connection.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("connection"))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("connection", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.ConnectionNames)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *showCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	connection, err := ResolveConnection(exec, uuid, c.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnelRows := []output.TableRow{}
+	for _, tunnel := range connection.Tunnels {
+		tunnelRows = append(tunnelRows, output.TableRow{
+			tunnel.UUID,
+			tunnel.Name,
+			tunnel.LocalAddress.Name,
+			tunnel.RemoteAddress.Address,
+			tunnel.OperationalState,
+		})
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows: []output.DetailRow{
+							{Title: "UUID:", Key: "uuid", Value: connection.UUID, Colour: ui.DefaultUUUIDColours},
+							{Title: "Name:", Key: "name", Value: connection.Name},
+							{Title: "Type:", Key: "type", Value: connection.Type},
+							{Title: "Created:", Key: "created_at", Value: connection.CreatedAt},
+							{Title: "Updated:", Key: "updated_at", Value: connection.UpdatedAt},
+						},
+					},
+				},
+			},
+		},
+		routesSection("local_routes", "Local routes:", connection.LocalRoutes),
+		routesSection("remote_routes", "Remote routes:", connection.RemoteRoutes),
+		output.CombinedSection{
+			Key:   "tunnels",
+			Title: "Tunnels:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+					{Key: "name", Header: "Name"},
+					{Key: "local_address", Header: "Local address"},
+					{Key: "remote_address", Header: "Remote address", Colour: ui.DefaultAddressColours},
+					{Key: "operational_state", Header: "Operational state"},
+				},
+				Rows:         tunnelRows,
+				EmptyMessage: "No tunnels defined for this connection.",
+			},
+		},
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value:  connection,
+		Output: combined,
+	}, nil
+}
+
+func routesSection(key, title string, routes []upcloud.GatewayRoute) output.CombinedSection {
+	rows := []output.TableRow{}
+	for _, route := range routes {
+		rows = append(rows, output.TableRow{
+			route.Name,
+			route.StaticNetwork,
+			route.Type,
+		})
+	}
+
+	return output.CombinedSection{
+		Key:   key,
+		Title: title,
+		Contents: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "static_network", Header: "Static network", Colour: ui.DefaultAddressColours},
+				{Key: "type", Header: "Type"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No routes defined.",
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package gatewayconnectiontunnel
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	gatewayconnection "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "gateway connection tunnel create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a tunnel to a gateway VPN connection",
+			`upctl gateway connection tunnel create my-gateway \
+				--connection to-office \
+				--name tunnel-1 \
+				--local-address public-ip-1 \
+				--remote-address 198.51.100.10 \
+				--psk-file psk.txt`,
+			`pass show office-vpn | upctl gateway connection tunnel create my-gateway \
+				--connection to-office \
+				--name tunnel-1 \
+				--local-address public-ip-1 \
+				--remote-address 198.51.100.10 \
+				--psk-file - \
+				--phase1-algorithm aes256 \
+				--phase1-integrity-algorithm sha256 \
+				--phase1-dh-group 14`,
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection    string
+	name          string
+	localAddress  string
+	remoteAddress string
+	ipsec         ipsecParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.connection, "connection", "", "Name or UUID of the connection.")
+	fs.StringVar(&c.name, "name", "", "Tunnel name.")
+	fs.StringVar(&c.localAddress, "local-address", "", "Name of the gateway address to use as the local endpoint of the tunnel.")
+	fs.StringVar(&c.remoteAddress, "remote-address", "", "Public IP address of the remote peer.")
+	c.ipsec.addFlags(fs)
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"connection", "name", "local-address", "remote-address", "psk-file"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	for _, flag := range []string{"name", "remote-address"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	registerIPSecFlagCompletions(c.Cobra())
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("connection", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.ConnectionNames)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("local-address", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.AddressNames)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *createCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	if ip := net.ParseIP(c.remoteAddress); ip == nil {
+		return nil, fmt.Errorf("invalid --remote-address value %q, must be an IP address", c.remoteAddress)
+	}
+
+	ipsec, err := c.ipsec.processParams(c.Cobra().InOrStdin())
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := gatewayconnection.ResolveConnection(exec, uuid, c.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating tunnel %s to connection %s of gateway %s", c.name, connection.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateGatewayConnectionTunnel(exec.Context(), &request.CreateGatewayConnectionTunnelRequest{
+		ServiceUUID:    uuid,
+		ConnectionUUID: connection.UUID,
+		Tunnel: request.GatewayTunnel{
+			Name:          c.name,
+			LocalAddress:  upcloud.GatewayTunnelLocalAddress{Name: c.localAddress},
+			RemoteAddress: upcloud.GatewayTunnelRemoteAddress{Address: c.remoteAddress},
+			IPSec:         ipsec,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
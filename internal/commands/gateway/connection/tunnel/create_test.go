@@ -0,0 +1,114 @@
+package gatewayconnectiontunnel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	gatewayUUID := "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a"
+	connection := upcloud.GatewayConnection{
+		UUID: "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+		Name: "to-office",
+	}
+	requiredArgs := []string{
+		gatewayUUID,
+		"--connection", "to-office",
+		"--name", "tunnel-1",
+		"--local-address", "public-ip-1",
+		"--remote-address", "198.51.100.10",
+		"--psk-file", "-",
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		stdin    string
+		expected upcloud.GatewayTunnelIPSec
+		errorMsg string
+	}{
+		{
+			name:     "invalid remote address",
+			args:     []string{gatewayUUID, "--connection", "to-office", "--name", "tunnel-1", "--local-address", "public-ip-1", "--remote-address", "office", "--psk-file", "-"},
+			errorMsg: `invalid --remote-address value "office", must be an IP address`,
+		},
+		{
+			name:     "invalid algorithm",
+			args:     append(requiredArgs, "--phase1-algorithm", "aes256,des"),
+			stdin:    "secret\n",
+			errorMsg: "invalid --phase1-algorithm value \"des\", valid values are `aes128gcm16`, `aes128gcm128`, `aes192gcm16`, `aes192gcm128`, `aes256gcm16`, `aes256gcm128`, `aes128`, `aes192` and `aes256`",
+		},
+		{
+			name:     "invalid dh group",
+			args:     append(requiredArgs, "--phase2-dh-group", "1"),
+			stdin:    "secret\n",
+			errorMsg: "invalid --phase2-dh-group value 1, valid values are `2`, `5`, `14`, `15`, `16`, `18`, `19`, `20`, `21`, `22`, `23`, `24`, `30`, `31` and `32`",
+		},
+		{
+			name:     "empty psk",
+			args:     requiredArgs,
+			stdin:    "\n",
+			errorMsg: "pre-shared key is empty",
+		},
+		{
+			name: "psk from stdin with ipsec options",
+			args: append(requiredArgs,
+				"--phase1-algorithm", "aes256",
+				"--phase1-integrity-algorithm", "sha256",
+				"--phase1-dh-group", "14,19",
+				"--dpd-delay", "30",
+			),
+			stdin: "secret\n",
+			expected: upcloud.GatewayTunnelIPSec{
+				Authentication: upcloud.GatewayTunnelIPSecAuth{
+					Authentication: upcloud.GatewayTunnelIPSecAuthTypePSK,
+					PSK:            "secret",
+				},
+				DPDDelay:                  30,
+				Phase1Algorithms:          []upcloud.GatewayIPSecAlgorithm{upcloud.GatewayIPSecAlgorithm_aes256},
+				Phase1IntegrityAlgorithms: []upcloud.GatewayIPSecIntegrityAlgorithm{upcloud.GatewayIPSecIntegrityAlgorithm_sha256},
+				Phase1DHGroupNumbers:      []int{14, 19},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := request.CreateGatewayConnectionTunnelRequest{
+				ServiceUUID:    gatewayUUID,
+				ConnectionUUID: connection.UUID,
+				Tunnel: request.GatewayTunnel{
+					Name:          "tunnel-1",
+					LocalAddress:  upcloud.GatewayTunnelLocalAddress{Name: "public-ip-1"},
+					RemoteAddress: upcloud.GatewayTunnelRemoteAddress{Address: "198.51.100.10"},
+					IPSec:         test.expected,
+				},
+			}
+			mService.On("GetGatewayConnections", &request.GetGatewayConnectionsRequest{ServiceUUID: gatewayUUID}).Return([]upcloud.GatewayConnection{connection}, nil)
+			mService.On("CreateGatewayConnectionTunnel", &expected).Return(&upcloud.GatewayTunnel{Name: "tunnel-1"}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			c.Cobra().SetIn(strings.NewReader(test.stdin))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateGatewayConnectionTunnel", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package gatewayconnectiontunnel
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	gatewayconnection "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// DeleteCommand creates the "gateway connection tunnel delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a tunnel from a gateway VPN connection",
+			"upctl gateway connection tunnel delete my-gateway --connection to-office --tunnel tunnel-1",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+	tunnel     string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *deleteCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.connection, "connection", "", "Name or UUID of the connection.")
+	fs.StringVar(&c.tunnel, "tunnel", "", "Name or UUID of the tunnel.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"connection", "tunnel"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("connection", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.ConnectionNames)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("tunnel", tunnelCompletionFunc(cfg, &c.connection)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *deleteCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	connection, err := gatewayconnection.ResolveConnection(exec, uuid, c.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnel, err := resolveTunnel(connection, c.tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Deleting tunnel %s of connection %s of gateway %s", tunnel.Name, connection.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	err = exec.All().DeleteGatewayConnectionTunnel(exec.Context(), &request.DeleteGatewayConnectionTunnelRequest{
+		ServiceUUID:    uuid,
+		ConnectionUUID: connection.UUID,
+		UUID:           tunnel.UUID,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
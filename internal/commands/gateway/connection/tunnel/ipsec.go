@@ -0,0 +1,198 @@
+package gatewayconnectiontunnel
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	algorithms = []string{
+		string(upcloud.GatewayIPSecAlgorithm_aes128gcm16),
+		string(upcloud.GatewayIPSecAlgorithm_aes128gcm128),
+		string(upcloud.GatewayIPSecAlgorithm_aes192gcm16),
+		string(upcloud.GatewayIPSecAlgorithm_aes192gcm128),
+		string(upcloud.GatewayIPSecAlgorithm_aes256gcm16),
+		string(upcloud.GatewayIPSecAlgorithm_aes256gcm128),
+		string(upcloud.GatewayIPSecAlgorithm_aes128),
+		string(upcloud.GatewayIPSecAlgorithm_aes192),
+		string(upcloud.GatewayIPSecAlgorithm_aes256),
+	}
+	integrityAlgorithms = []string{
+		string(upcloud.GatewayIPSecIntegrityAlgorithm_aes128gmac),
+		string(upcloud.GatewayIPSecIntegrityAlgorithm_aes256gmac),
+		string(upcloud.GatewayIPSecIntegrityAlgorithm_sha1),
+		string(upcloud.GatewayIPSecIntegrityAlgorithm_sha256),
+		string(upcloud.GatewayIPSecIntegrityAlgorithm_sha384),
+		string(upcloud.GatewayIPSecIntegrityAlgorithm_sha512),
+	}
+	dhGroupNumbers = []int{2, 5, 14, 15, 16, 18, 19, 20, 21, 22, 23, 24, 30, 31, 32}
+)
+
+// ipsecParams contains the IPsec parameters of a tunnel. Zero values are not sent to the API, so that the API defaults are used for parameters that are not defined.
+type ipsecParams struct {
+	pskFile                   string
+	rekeyTime                 int
+	childRekeyTime            int
+	dpdDelay                  int
+	dpdTimeout                int
+	ikeLifetime               int
+	phase1Algorithms          []string
+	phase1IntegrityAlgorithms []string
+	phase1DHGroupNumbers      []int
+	phase2Algorithms          []string
+	phase2IntegrityAlgorithms []string
+	phase2DHGroupNumbers      []int
+}
+
+func (p *ipsecParams) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.pskFile, "psk-file", "", "Path to a file containing the pre-shared key. Use `-` to read the key from stdin.")
+	fs.IntVar(&p.rekeyTime, "rekey-time", 0, "IKE SA rekey time in seconds.")
+	fs.IntVar(&p.childRekeyTime, "child-rekey-time", 0, "IKE child SA rekey time in seconds.")
+	fs.IntVar(&p.dpdDelay, "dpd-delay", 0, "Delay in seconds before sending Dead Peer Detection packets if no traffic is detected.")
+	fs.IntVar(&p.dpdTimeout, "dpd-timeout", 0, "Timeout in seconds for Dead Peer Detection reply before considering the peer to be dead.")
+	fs.IntVar(&p.ikeLifetime, "ike-lifetime", 0, "Maximum IKE SA lifetime in seconds.")
+	fs.StringSliceVar(&p.phase1Algorithms, "phase1-algorithm", nil, "Phase 1 (IKE) encryption algorithms, multiple can be declared. Valid values are "+namedargs.ValidValuesHelp(algorithms...)+".")
+	fs.StringSliceVar(&p.phase1IntegrityAlgorithms, "phase1-integrity-algorithm", nil, "Phase 1 (IKE) integrity algorithms, multiple can be declared. Valid values are "+namedargs.ValidValuesHelp(integrityAlgorithms...)+".")
+	fs.IntSliceVar(&p.phase1DHGroupNumbers, "phase1-dh-group", nil, "Phase 1 (IKE) Diffie-Hellman group numbers, multiple can be declared. Valid values are "+namedargs.ValidValuesHelp(dhGroupNumberStrings()...)+".")
+	fs.StringSliceVar(&p.phase2Algorithms, "phase2-algorithm", nil, "Phase 2 (ESP) encryption algorithms, multiple can be declared. Valid values are "+namedargs.ValidValuesHelp(algorithms...)+".")
+	fs.StringSliceVar(&p.phase2IntegrityAlgorithms, "phase2-integrity-algorithm", nil, "Phase 2 (ESP) integrity algorithms, multiple can be declared. Valid values are "+namedargs.ValidValuesHelp(integrityAlgorithms...)+".")
+	fs.IntSliceVar(&p.phase2DHGroupNumbers, "phase2-dh-group", nil, "Phase 2 (ESP) Diffie-Hellman group numbers, multiple can be declared. Valid values are "+namedargs.ValidValuesHelp(dhGroupNumberStrings()...)+".")
+}
+
+func registerIPSecFlagCompletions(cmd *cobra.Command) {
+	for _, flag := range []string{"phase1-algorithm", "phase2-algorithm"} {
+		commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.FixedCompletions(algorithms, cobra.ShellCompDirectiveNoFileComp)))
+	}
+	for _, flag := range []string{"phase1-integrity-algorithm", "phase2-integrity-algorithm"} {
+		commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.FixedCompletions(integrityAlgorithms, cobra.ShellCompDirectiveNoFileComp)))
+	}
+	for _, flag := range []string{"phase1-dh-group", "phase2-dh-group"} {
+		commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.FixedCompletions(dhGroupNumberStrings(), cobra.ShellCompDirectiveNoFileComp)))
+	}
+	for _, flag := range []string{"rekey-time", "child-rekey-time", "dpd-delay", "dpd-timeout", "ike-lifetime"} {
+		commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+// processParams validates the IPsec parameters and reads the pre-shared key. The values are validated locally to catch typos before sending the request.
+func (p *ipsecParams) processParams(stdin io.Reader) (upcloud.GatewayTunnelIPSec, error) {
+	ipsec := upcloud.GatewayTunnelIPSec{
+		RekeyTime:      p.rekeyTime,
+		ChildRekeyTime: p.childRekeyTime,
+		DPDDelay:       p.dpdDelay,
+		DPDTimeout:     p.dpdTimeout,
+		IKELifetime:    p.ikeLifetime,
+	}
+
+	for _, v := range []struct {
+		flag  string
+		value int
+	}{
+		{"rekey-time", p.rekeyTime},
+		{"child-rekey-time", p.childRekeyTime},
+		{"dpd-delay", p.dpdDelay},
+		{"dpd-timeout", p.dpdTimeout},
+		{"ike-lifetime", p.ikeLifetime},
+	} {
+		if v.value < 0 {
+			return ipsec, fmt.Errorf("invalid --%s value %d, must be a positive number of seconds", v.flag, v.value)
+		}
+	}
+
+	var err error
+	if ipsec.Phase1Algorithms, err = parseValues[upcloud.GatewayIPSecAlgorithm]("phase1-algorithm", p.phase1Algorithms, algorithms); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase1IntegrityAlgorithms, err = parseValues[upcloud.GatewayIPSecIntegrityAlgorithm]("phase1-integrity-algorithm", p.phase1IntegrityAlgorithms, integrityAlgorithms); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase1DHGroupNumbers, err = validateDHGroupNumbers("phase1-dh-group", p.phase1DHGroupNumbers); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase2Algorithms, err = parseValues[upcloud.GatewayIPSecAlgorithm]("phase2-algorithm", p.phase2Algorithms, algorithms); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase2IntegrityAlgorithms, err = parseValues[upcloud.GatewayIPSecIntegrityAlgorithm]("phase2-integrity-algorithm", p.phase2IntegrityAlgorithms, integrityAlgorithms); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase2DHGroupNumbers, err = validateDHGroupNumbers("phase2-dh-group", p.phase2DHGroupNumbers); err != nil {
+		return ipsec, err
+	}
+
+	if p.pskFile != "" {
+		psk, err := readPSK(p.pskFile, stdin)
+		if err != nil {
+			return ipsec, err
+		}
+		ipsec.Authentication = upcloud.GatewayTunnelIPSecAuth{
+			Authentication: upcloud.GatewayTunnelIPSecAuthTypePSK,
+			PSK:            psk,
+		}
+	}
+
+	return ipsec, nil
+}
+
+func parseValues[T ~string](flag string, values, valid []string) ([]T, error) {
+	var parsed []T
+	for _, v := range values {
+		if !slices.Contains(valid, v) {
+			return nil, fmt.Errorf("invalid --%s value %q, valid values are %s", flag, v, namedargs.ValidValuesHelp(valid...))
+		}
+		parsed = append(parsed, T(v))
+	}
+	return parsed, nil
+}
+
+func validateDHGroupNumbers(flag string, values []int) ([]int, error) {
+	for _, v := range values {
+		if !slices.Contains(dhGroupNumbers, v) {
+			return nil, fmt.Errorf("invalid --%s value %d, valid values are %s", flag, v, namedargs.ValidValuesHelp(dhGroupNumberStrings()...))
+		}
+	}
+	return values, nil
+}
+
+func dhGroupNumberStrings() []string {
+	var values []string
+	for _, v := range dhGroupNumbers {
+		values = append(values, strconv.Itoa(v))
+	}
+	return values
+}
+
+// readPSK reads the pre-shared key from the given file, or from stdin if path is `-`. Only the first line is used, so that a trailing newline is not included in the key.
+func readPSK(path string, stdin io.Reader) (string, error) {
+	var r io.Reader = stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("cannot read pre-shared key: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("cannot read pre-shared key: %w", err)
+	}
+
+	psk := strings.TrimRight(line, "\r\n")
+	if psk == "" {
+		return "", fmt.Errorf("pre-shared key is empty")
+	}
+	return psk, nil
+}
@@ -0,0 +1,91 @@
+package gatewayconnectiontunnel
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	gatewayconnection "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ListCommand creates the "gateway connection tunnel list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List tunnels of a gateway VPN connection",
+			"upctl gateway connection tunnel list my-gateway --connection to-office",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *listCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.connection, "connection", "", "Name or UUID of the connection.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("connection"))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *listCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("connection", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.ConnectionNames)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	connection, err := gatewayconnection.ResolveConnection(exec, uuid, c.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnels, err := exec.All().GetGatewayConnectionTunnels(exec.Context(), &request.GetGatewayConnectionTunnelsRequest{
+		ServiceUUID:    uuid,
+		ConnectionUUID: connection.UUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, tunnel := range tunnels {
+		rows = append(rows, output.TableRow{
+			tunnel.UUID,
+			tunnel.Name,
+			tunnel.LocalAddress.Name,
+			tunnel.RemoteAddress.Address,
+			tunnel.OperationalState,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: tunnels,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				{Key: "name", Header: "Name"},
+				{Key: "local_address", Header: "Local address"},
+				{Key: "remote_address", Header: "Remote address", Colour: ui.DefaultAddressColours},
+				{Key: "operational_state", Header: "Operational state"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No tunnels defined for this connection.",
+		},
+	}, nil
+}
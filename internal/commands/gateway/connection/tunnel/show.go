@@ -0,0 +1,106 @@
+package gatewayconnectiontunnel
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/spf13/pflag"
+)
+
+// ShowCommand creates the "gateway connection tunnel show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show tunnel details",
+			"upctl gateway connection tunnel show my-gateway --connection to-office --tunnel tunnel-1",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+	tunnel     string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *showCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.connection, "connection", "", "Name or UUID of the connection.")
+	fs.StringVar(&c.tunnel, "tunnel", "", "Name or UUID of the tunnel.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"connection", "tunnel"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("connection", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, gateway.ConnectionNames)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("tunnel", tunnelCompletionFunc(cfg, &c.connection)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *showCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	connection, tunnel, err := getTunnel(exec, uuid, c.connection, c.tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	ipsec := tunnel.IPSec
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows: []output.DetailRow{
+							{Title: "UUID:", Key: "uuid", Value: tunnel.UUID, Colour: ui.DefaultUUUIDColours},
+							{Title: "Name:", Key: "name", Value: tunnel.Name},
+							{Title: "Connection:", Key: "connection", Value: connection.Name},
+							{Title: "Local address:", Key: "local_address", Value: tunnel.LocalAddress.Name},
+							{Title: "Remote address:", Key: "remote_address", Value: tunnel.RemoteAddress.Address, Colour: ui.DefaultAddressColours},
+							{Title: "Operational state:", Key: "operational_state", Value: tunnel.OperationalState},
+							{Title: "Created:", Key: "created_at", Value: tunnel.CreatedAt},
+							{Title: "Updated:", Key: "updated_at", Value: tunnel.UpdatedAt},
+						},
+					},
+					{
+						Title: "IPsec:",
+						Rows: []output.DetailRow{
+							{Title: "Authentication:", Key: "authentication", Value: ipsec.Authentication.Authentication},
+							{Title: "Rekey time:", Key: "rekey_time", Value: ipsec.RekeyTime},
+							{Title: "Child rekey time:", Key: "child_rekey_time", Value: ipsec.ChildRekeyTime},
+							{Title: "DPD delay:", Key: "dpd_delay", Value: ipsec.DPDDelay},
+							{Title: "DPD timeout:", Key: "dpd_timeout", Value: ipsec.DPDTimeout},
+							{Title: "IKE lifetime:", Key: "ike_lifetime", Value: ipsec.IKELifetime},
+							{Title: "Phase 1 algorithms:", Key: "phase1_algorithms", Value: ipsec.Phase1Algorithms, Format: format.StringSliceAnd},
+							{Title: "Phase 1 integrity algorithms:", Key: "phase1_integrity_algorithms", Value: ipsec.Phase1IntegrityAlgorithms, Format: format.StringSliceAnd},
+							{Title: "Phase 1 DH groups:", Key: "phase1_dh_group_numbers", Value: ipsec.Phase1DHGroupNumbers, Format: format.StringSliceAnd},
+							{Title: "Phase 2 algorithms:", Key: "phase2_algorithms", Value: ipsec.Phase2Algorithms, Format: format.StringSliceAnd},
+							{Title: "Phase 2 integrity algorithms:", Key: "phase2_integrity_algorithms", Value: ipsec.Phase2IntegrityAlgorithms, Format: format.StringSliceAnd},
+							{Title: "Phase 2 DH groups:", Key: "phase2_dh_group_numbers", Value: ipsec.Phase2DHGroupNumbers, Format: format.StringSliceAnd},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value:  tunnel,
+		Output: combined,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package gatewayconnectiontunnel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	gatewayconnection "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// BaseTunnelCommand creates the base "gateway connection tunnel" command
+func BaseTunnelCommand() commands.Command {
+	return &tunnelCommand{
+		commands.New("tunnel", "Manage tunnels of gateway VPN connections"),
+	}
+}
+
+type tunnelCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *tunnelCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"tunnels"}
+}
+
+// resolveTunnel finds a tunnel of the given connection by name or UUID.
+func resolveTunnel(connection upcloud.GatewayConnection, arg string) (upcloud.GatewayTunnel, error) {
+	var matches []upcloud.GatewayTunnel
+	for _, tunnel := range connection.Tunnels {
+		if tunnel.UUID == arg || tunnel.Name == arg {
+			matches = append(matches, tunnel)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return upcloud.GatewayTunnel{}, fmt.Errorf("tunnel %q not found in connection %s", arg, connection.Name)
+	case 1:
+		return matches[0], nil
+	default:
+		return upcloud.GatewayTunnel{}, fmt.Errorf("tunnel name %q is ambiguous in connection %s, use UUID instead", arg, connection.Name)
+	}
+}
+
+// tunnelCompletionFunc completes names of the tunnels of the connection given with `--connection` flag.
+func tunnelCompletionFunc(cfg *config.Config, connection *string) namedargs.CompleteFunc {
+	return namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingGateway{}, cfg, func(ctx context.Context, svc service.AllServices, uuid string) ([]string, error) {
+		connections, err := svc.GetGatewayConnections(ctx, &request.GetGatewayConnectionsRequest{ServiceUUID: uuid})
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, conn := range connections {
+			if conn.Name != *connection && conn.UUID != *connection {
+				continue
+			}
+			for _, tunnel := range conn.Tunnels {
+				names = append(names, tunnel.Name)
+			}
+		}
+		return names, nil
+	})
+}
+
+// getTunnel resolves the connection and the tunnel given with flags and fetches the tunnel details.
+func getTunnel(exec commands.Executor, gatewayUUID, connectionArg, tunnelArg string) (upcloud.GatewayConnection, *upcloud.GatewayTunnel, error) {
+	connection, err := gatewayconnection.ResolveConnection(exec, gatewayUUID, connectionArg)
+	if err != nil {
+		return connection, nil, err
+	}
+
+	tunnel, err := resolveTunnel(connection, tunnelArg)
+	if err != nil {
+		return connection, nil, err
+	}
+
+	res, err := exec.All().GetGatewayConnectionTunnel(exec.Context(), &request.GetGatewayConnectionTunnelRequest{
+		ServiceUUID:    gatewayUUID,
+		ConnectionUUID: connection.UUID,
+		UUID:           tunnel.UUID,
+	})
+	return connection, res, err
+}
@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	features = []string{
+		string(upcloud.GatewayFeatureNAT),
+		string(upcloud.GatewayFeatureVPN),
+	}
+	configuredStatuses = []string{
+		string(upcloud.GatewayConfiguredStatusStarted),
+		string(upcloud.GatewayConfiguredStatusStopped),
+	}
+)
+
+// CreateCommand creates the "gateway create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a gateway",
+			"upctl gateway create --name my-gateway --zone fi-hel1 --feature nat --router my-router",
+			`upctl gateway create \
+				--name my-vpn-gateway \
+				--zone de-fra1 \
+				--plan advanced \
+				--feature nat \
+				--feature vpn \
+				--router 04d031ab-4b85-4cbc-9f0e-6a2977541327 \
+				--address public-ip-1 \
+				--label env=prod \
+				--wait`,
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+
+	name             string
+	zone             string
+	plan             string
+	features         []string
+	router           string
+	addresses        []string
+	labels           []string
+	configuredStatus string
+	wait             config.OptionalBoolean
+	cfg              *config.Config
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Gateway name.")
+	fs.StringVar(&c.zone, "zone", "", namedargs.ZoneDescription("gateway"))
+	fs.StringVar(&c.plan, "plan", "", "Plan to use for the gateway. Run `upctl gateway plans` to list all available plans. Defaults to the plan chosen by the API if not provided.")
+	fs.StringArrayVar(&c.features, "feature", nil, "Feature to enable on the gateway, multiple can be declared. Valid values are "+namedargs.ValidValuesHelp(features...)+".")
+	fs.StringVar(&c.router, "router", "", "Router to attach the gateway to, specified by router UUID or router name.")
+	fs.StringArrayVar(&c.addresses, "address", nil, "Name of a public IP address to allocate for the gateway, multiple can be declared. VPN tunnels refer to these addresses by name.")
+	fs.StringArrayVar(&c.labels, "label", nil, "Labels to describe the gateway in `key=value` format, multiple can be declared.")
+	fs.StringVar(&c.configuredStatus, "configured-status", string(upcloud.GatewayConfiguredStatusStarted), "Configured status of the gateway. Valid values are "+namedargs.ValidValuesHelp(configuredStatuses...)+".")
+	config.AddToggleFlag(fs, &c.wait, "wait", false, "Wait for gateway to be in running state before returning. Gateways with stopped configured status are not waited for. Ignored in dry-run mode.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"name", "zone", "feature", "router"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	for _, flag := range []string{"name", "address", "label"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("feature", cobra.FixedCompletions(features, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("configured-status", cobra.FixedCompletions(configuredStatuses, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.cfg = cfg
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("plan", namedargs.CompletionFunc(completion.GatewayPlan{}, cfg)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("router", namedargs.CompletionFunc(completion.Router{}, cfg)))
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (c *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	req := request.CreateGatewayRequest{
+		Name:             c.name,
+		Zone:             c.zone,
+		Plan:             c.plan,
+		ConfiguredStatus: upcloud.GatewayConfiguredStatus(c.configuredStatus),
+	}
+
+	for _, feature := range c.features {
+		req.Features = append(req.Features, upcloud.GatewayFeature(feature))
+	}
+
+	for _, address := range c.addresses {
+		req.Addresses = append(req.Addresses, upcloud.GatewayAddress{Name: address})
+	}
+
+	if len(c.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(c.labels)
+		if err != nil {
+			return nil, err
+		}
+		req.Labels = labelSlice
+	}
+
+	routerUUID, err := namedargs.ResolveRouter(exec, c.router)
+	if err != nil {
+		return nil, err
+	}
+	req.Routers = []request.GatewayRouter{{UUID: routerUUID}}
+
+	msg := fmt.Sprintf("Creating gateway %s", req.Name)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateGateway(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	// In dry-run mode, the gateway does not exist and there is nothing to wait for. Stopped gateways are not waited for, as the API does not define an operational state for them.
+	if c.wait.Value() && !c.cfg.DryRun() && res.ConfiguredStatus == upcloud.GatewayConfiguredStatusStarted {
+		waitForOperationalState(res.UUID, upcloud.GatewayOperationalStateRunning, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
@@ -0,0 +1,152 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	router := upcloud.Router{
+		Name: "my-router",
+		UUID: "04d031ab-4b85-4cbc-9f0e-6a2977541327",
+	}
+	gateway := upcloud.Gateway{
+		Name:             "my-gateway",
+		UUID:             "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a",
+		OperationalState: upcloud.GatewayOperationalStateRunning,
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateGatewayRequest
+		errorMsg string
+	}{
+		{
+			name:     "missing router",
+			args:     []string{"--name", "my-gateway", "--zone", "fi-hel1", "--feature", "nat"},
+			errorMsg: `required flag(s) "router" not set`,
+		},
+		{
+			name: "nat gateway with router name",
+			args: []string{"--name", "my-gateway", "--zone", "fi-hel1", "--feature", "nat", "--router", "my-router"},
+			expected: request.CreateGatewayRequest{
+				Name:             "my-gateway",
+				Zone:             "fi-hel1",
+				Features:         []upcloud.GatewayFeature{upcloud.GatewayFeatureNAT},
+				Routers:          []request.GatewayRouter{{UUID: router.UUID}},
+				ConfiguredStatus: upcloud.GatewayConfiguredStatusStarted,
+			},
+		},
+		{
+			name: "vpn gateway with all options",
+			args: []string{
+				"--name", "my-gateway",
+				"--zone", "de-fra1",
+				"--plan", "advanced",
+				"--feature", "nat",
+				"--feature", "vpn",
+				"--router", router.UUID,
+				"--address", "public-ip-1",
+				"--label", "env=prod",
+				"--configured-status", "stopped",
+				"--wait",
+			},
+			expected: request.CreateGatewayRequest{
+				Name:             "my-gateway",
+				Zone:             "de-fra1",
+				Plan:             "advanced",
+				Features:         []upcloud.GatewayFeature{upcloud.GatewayFeatureNAT, upcloud.GatewayFeatureVPN},
+				Routers:          []request.GatewayRouter{{UUID: router.UUID}},
+				Addresses:        []upcloud.GatewayAddress{{Name: "public-ip-1"}},
+				Labels:           []upcloud.Label{{Key: "env", Value: "prod"}},
+				ConfiguredStatus: upcloud.GatewayConfiguredStatusStopped,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("GetRouters").Return(&upcloud.Routers{Routers: []upcloud.Router{router}}, nil)
+			mService.On("CreateGateway", &expected).Return(&gateway, nil)
+			mService.On("GetGateway", mock.Anything).Return(&gateway, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateGateway", 1)
+			}
+		})
+	}
+}
+
+func TestCreateCommand_Wait(t *testing.T) {
+	router := upcloud.Router{
+		Name: "my-router",
+		UUID: "04d031ab-4b85-4cbc-9f0e-6a2977541327",
+	}
+	gatewayUUID := "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a"
+
+	for _, test := range []struct {
+		name   string
+		status upcloud.GatewayConfiguredStatus
+		state  upcloud.GatewayOperationalState
+		dryRun bool
+		polled bool
+	}{
+		{
+			name:   "started",
+			status: upcloud.GatewayConfiguredStatusStarted,
+			state:  upcloud.GatewayOperationalStateRunning,
+			polled: true,
+		},
+		{
+			name:   "stopped",
+			status: upcloud.GatewayConfiguredStatusStopped,
+		},
+		{
+			name:   "dry-run",
+			status: upcloud.GatewayConfiguredStatusStarted,
+			dryRun: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			conf.Viper().Set(config.KeyDryRun, test.dryRun)
+			mService := new(smock.Service)
+
+			created := upcloud.Gateway{UUID: gatewayUUID, ConfiguredStatus: test.status}
+			mService.On("GetRouters").Return(&upcloud.Routers{Routers: []upcloud.Router{router}}, nil)
+			mService.On("CreateGateway", mock.Anything).Return(&created, nil)
+			mService.On("GetGateway", &request.GetGatewayRequest{UUID: gatewayUUID}).Return(&upcloud.Gateway{UUID: gatewayUUID, OperationalState: test.state}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs([]string{"--name", "my-gateway", "--zone", "fi-hel1", "--feature", "nat", "--router", "my-router", "--configured-status", string(test.status), "--wait"})
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			require.NoError(t, err)
+			if test.polled {
+				mService.AssertNumberOfCalls(t, "GetGateway", 1)
+			} else {
+				mService.AssertNotCalled(t, "GetGateway", mock.Anything)
+			}
+		})
+	}
+}
@@ -1,7 +1,15 @@
 package gateway
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 )
 
 // BaseGatewayCommand creates the base "gateway" command
@@ -19,3 +27,46 @@ type gatewayCommand struct {
 func (gw *gatewayCommand) InitCommand() {
 	gw.Cobra().Aliases = []string{"gw"}
 }
+
+// waitForOperationalState waits for gateway to reach given operational state and updates progress message with key matching given msg. Finally, progress message is updated back to given msg and either done state or timeout warning.
+func waitForOperationalState(uuid string, state upcloud.GatewayOperationalState, exec commands.Executor, msg string) {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for gateway %s to be in %s state", uuid, state))
+
+	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
+	defer cancel()
+
+	if err := pollOperationalState(ctx, exec, uuid, state); err != nil {
+		exec.PushProgressUpdate(messages.Update{
+			Key:     msg,
+			Message: msg,
+			Status:  messages.MessageStatusWarning,
+			Details: "Error: " + err.Error(),
+		})
+		return
+	}
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	exec.PushProgressSuccess(msg)
+}
+
+func pollOperationalState(ctx context.Context, exec commands.Executor, uuid string, state upcloud.GatewayOperationalState) error {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	svc := exec.All()
+	for {
+		gtw, err := svc.GetGateway(ctx, &request.GetGatewayRequest{UUID: uuid})
+		if err != nil {
+			return err
+		}
+		if gtw.OperationalState == state {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/terminal"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// MetricsCommand creates the "gateway metrics" command
+func MetricsCommand() commands.Command {
+	return &metricsCommand{
+		BaseCommand: commands.New(
+			"metrics",
+			"Show gateway metrics and VPN tunnel health",
+			"upctl gateway metrics my-gateway",
+			"upctl gateway metrics my-gateway --watch 10s",
+			"upctl gateway metrics my-gateway --output json",
+		),
+	}
+}
+
+type metricsCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	cfg   *config.Config
+	watch time.Duration
+}
+
+// InitCommand implements Command.InitCommand
+func (c *metricsCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.DurationVar(&c.watch, "watch", 0, "Re-render the metrics periodically with the given interval, e.g. `10s`, until interrupted. JSON output is written as one line per interval. Failed requests are retried on the next interval.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("watch", cobra.NoFileCompletions))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *metricsCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.cfg = cfg
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *metricsCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	if c.watch == 0 {
+		return getMetrics(exec, uuid)
+	}
+	if c.watch < time.Second {
+		return nil, fmt.Errorf("invalid --watch value %s, interval must be at least 1s", c.watch)
+	}
+
+	ticker := time.NewTicker(c.watch)
+	defer ticker.Stop()
+
+	w := c.Cobra().OutOrStdout()
+	for {
+		metrics, err := exec.All().GetGatewayMetrics(exec.Context(), &request.GetGatewayMetricsRequest{ServiceUUID: uuid})
+		switch {
+		case err != nil && exec.Context().Err() != nil:
+			return output.None{}, nil
+		case err != nil && !isTransientError(err):
+			return nil, err
+		case err != nil:
+			// Keep watching, the metrics are fetched again on the next tick
+			exec.PushProgressUpdate(messages.Update{
+				Message: fmt.Sprintf("Fetching metrics of gateway %s failed, retrying in %s", uuid, c.watch),
+				Status:  messages.MessageStatusWarning,
+				Details: "Error: " + err.Error(),
+			})
+		default:
+			if err := c.writeMetrics(w, uuid, metrics); err != nil {
+				return nil, err
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-exec.Context().Done():
+			return output.None{}, nil
+		}
+	}
+}
+
+// writeMetrics writes the metrics of a single watch tick to w. JSON output is written as one compact document per line and YAML output as a stream of documents, so that the output can be parsed while watching. Human output replaces the previous metrics, if writing to a terminal.
+func (c *metricsCommand) writeMetrics(w io.Writer, uuid string, metrics *upcloud.GatewayMetrics) error {
+	switch {
+	case c.cfg.Output() == config.ValueOutputJSON:
+		line, err := json.Marshal(metrics)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", line)
+		return err
+	case c.cfg.Output() == config.ValueOutputYAML:
+		if _, err := fmt.Fprintln(w, "---"); err != nil {
+			return err
+		}
+	case c.cfg.OutputHuman():
+		if terminal.IsStdoutTerminal() {
+			// Move the cursor to the top left corner and clear the screen
+			if _, err := fmt.Fprint(w, "\033[H\033[2J"); err != nil {
+				return err
+			}
+		} else if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "Metrics of gateway %s at %s\n", uuid, time.Now().Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+	return commands.RenderOutput(w, c.cfg, metricsOutput(metrics))
+}
+
+// isTransientError returns false for client errors, e.g. when the gateway does not exist, as retrying them would fail again.
+func isTransientError(err error) bool {
+	var problem *upcloud.Problem
+	if errors.As(err, &problem) {
+		return problem.Status == http.StatusTooManyRequests || problem.Status < 400 || problem.Status >= 500
+	}
+	return true
+}
+
+func getMetrics(exec commands.Executor, uuid string) (output.Output, error) {
+	metrics, err := exec.All().GetGatewayMetrics(exec.Context(), &request.GetGatewayMetricsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	return metricsOutput(metrics), nil
+}
+
+func metricsOutput(metrics *upcloud.GatewayMetrics) output.Output {
+	gatewayRows := []output.TableRow{}
+	for _, gtw := range metrics.Gateways {
+		gatewayRows = append(gatewayRows, output.TableRow{
+			gtw.Name,
+			gtw.ActiveConnections,
+			gtw.TotalAcceptedConnections,
+			gtw.TotalRejectedSessions,
+			gtw.UpdatedAt,
+		})
+	}
+
+	tunnelRows := []output.TableRow{}
+	childSARows := []output.TableRow{}
+	if metrics.IPSecMetrics != nil {
+		for _, sa := range metrics.IPSecMetrics.IKESAs {
+			health := upcloud.GatewayHeuristicState{}
+			if sa.HeuristicState != nil {
+				health = *sa.HeuristicState
+			}
+
+			tunnelRows = append(tunnelRows, output.TableRow{
+				sa.Name,
+				sa.OperationalState,
+				sa.LocalHost,
+				sa.RemoteHost,
+				formatSeconds(sa.Established),
+				health.TunnelUp,
+				health.TunnelHealthy,
+				health.UpEvents,
+				health.DownEvents,
+				health.LastDownMessage,
+			})
+
+			for _, child := range sa.ChildSAs {
+				childSARows = append(childSARows, output.TableRow{
+					sa.Name,
+					child.Name,
+					child.State,
+					child.BytesIn,
+					child.BytesOut,
+					child.PacketsIn,
+					child.PacketsOut,
+					formatSeconds(child.InstallTime),
+					strings.Join(child.LocalTrafficSelectors, ", "),
+					strings.Join(child.RemoteTrafficSelectors, ", "),
+				})
+			}
+		}
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Key:   "gateways",
+			Title: "Gateways:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "active_connections", Header: "Active connections"},
+					{Key: "total_accepted_connections", Header: "Accepted connections"},
+					{Key: "total_rejected_sessions", Header: "Rejected sessions"},
+					{Key: "updated_at", Header: "Updated"},
+				},
+				Rows:         gatewayRows,
+				EmptyMessage: "No gateway metrics available.",
+			},
+		},
+		output.CombinedSection{
+			Key:   "tunnels",
+			Title: "VPN tunnels:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "operational_state", Header: "State", Format: formatTunnelState},
+					{Key: "local_host", Header: "Local host"},
+					{Key: "remote_host", Header: "Remote host"},
+					{Key: "uptime", Header: "Uptime"},
+					{Key: "tunnel_up", Header: "Up", Format: format.Boolean},
+					{Key: "tunnel_healthy", Header: "Healthy", Format: format.Boolean},
+					{Key: "up_events", Header: "Up events", Hidden: true},
+					{Key: "down_events", Header: "Down events", Hidden: true},
+					{Key: "last_down_message", Header: "Last down message"},
+				},
+				Rows:         tunnelRows,
+				EmptyMessage: "No VPN tunnel metrics available.",
+			},
+		},
+		output.CombinedSection{
+			Key:   "child_sas",
+			Title: "VPN traffic:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "tunnel", Header: "Tunnel"},
+					{Key: "name", Header: "Child SA"},
+					{Key: "state", Header: "State", Format: formatTunnelState},
+					{Key: "bytes_in", Header: "Bytes in"},
+					{Key: "bytes_out", Header: "Bytes out"},
+					{Key: "packets_in", Header: "Packets in"},
+					{Key: "packets_out", Header: "Packets out"},
+					{Key: "uptime", Header: "Uptime"},
+					{Key: "local_traffic_selectors", Header: "Local traffic selectors", Hidden: true},
+					{Key: "remote_traffic_selectors", Header: "Remote traffic selectors", Hidden: true},
+				},
+				Rows:         childSARows,
+				EmptyMessage: "No VPN traffic metrics available.",
+			},
+		},
+	}
+
+	// For JSON and YAML output, passthrough API response
+	return output.MarshaledWithHumanOutput{
+		Value:  metrics,
+		Output: combined,
+	}
+}
+
+// formatSeconds formats a duration given in seconds in human readable form.
+func formatSeconds(seconds int) string {
+	if seconds <= 0 {
+		return ""
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func formatTunnelState(val any) (text.Colors, string, error) {
+	state, ok := val.(string)
+	if !ok {
+		return nil, "", fmt.Errorf("cannot parse state from %T, expected string", val)
+	}
+
+	switch strings.ToLower(state) {
+	case "established", "installed":
+		return text.Colors{text.FgGreen}, state, nil
+	case "":
+		return format.PossiblyUnknownString(state)
+	default:
+		return text.Colors{text.FgYellow}, state, nil
+	}
+}
@@ -0,0 +1,150 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var mockMetrics = upcloud.GatewayMetrics{
+	Gateways: []upcloud.GatewayMetricsGateway{
+		{Name: "gw-1", ActiveConnections: 12, TotalAcceptedConnections: 340, TotalRejectedSessions: 2},
+	},
+	IPSecMetrics: &upcloud.GatewayIPSecMetrics{
+		IKESAs: []upcloud.GatewayIKESA{{
+			Name:             "to-office-tunnel-1",
+			OperationalState: "ESTABLISHED",
+			LocalHost:        "94.237.0.10",
+			RemoteHost:       "198.51.100.10",
+			Established:      3725,
+			HeuristicState:   &upcloud.GatewayHeuristicState{TunnelUp: true, TunnelHealthy: true},
+			ChildSAs: []upcloud.GatewayChildSA{{
+				Name:        "to-office-tunnel-1",
+				State:       "INSTALLED",
+				BytesIn:     1024,
+				BytesOut:    2048,
+				PacketsIn:   10,
+				PacketsOut:  20,
+				InstallTime: 120,
+			}},
+		}},
+	},
+}
+
+func TestMetricsCommand(t *testing.T) {
+	text.DisableColors()
+	gatewayUUID := "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a"
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("GetGatewayMetrics", &request.GetGatewayMetricsRequest{ServiceUUID: gatewayUUID}).Return(&mockMetrics, nil)
+
+	c := commands.BuildCommand(MetricsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{gatewayUUID})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	for _, expected := range []string{
+		"gw-1",
+		"340",
+		"to-office-tunnel-1",
+		"ESTABLISHED",
+		"198.51.100.10",
+		"1h2m5s",
+		"INSTALLED",
+		"2048",
+		"2m0s",
+	} {
+		assert.Contains(t, out, expected)
+	}
+}
+
+func TestMetricsCommand_Watch(t *testing.T) {
+	gatewayUUID := "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a"
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	mService := new(smock.Service)
+
+	calls := 0
+	mService.On("GetGatewayMetrics", mock.Anything).Return(&mockMetrics, nil).Run(func(mock.Arguments) {
+		// Stop watching after the second render
+		calls++
+		if calls == 2 {
+			conf.Cancel()
+		}
+	})
+
+	c := commands.BuildCommand(MetricsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{gatewayUUID, "--watch", "1s"})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	mService.AssertNumberOfCalls(t, "GetGatewayMetrics", 2)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		assert.True(t, json.Valid([]byte(line)))
+		assert.Contains(t, line, `"ipsec_metrics"`)
+	}
+}
+
+func TestMetricsCommand_WatchRetriesTransientErrors(t *testing.T) {
+	gatewayUUID := "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a"
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	mService := new(smock.Service)
+
+	mService.On("GetGatewayMetrics", mock.Anything).Return(nil, &upcloud.Problem{Status: http.StatusBadGateway}).Once()
+	mService.On("GetGatewayMetrics", mock.Anything).Return(&mockMetrics, nil).Run(func(mock.Arguments) {
+		conf.Cancel()
+	}).Once()
+
+	c := commands.BuildCommand(MetricsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{gatewayUUID, "--watch", "1s"})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	mService.AssertNumberOfCalls(t, "GetGatewayMetrics", 2)
+	assert.Equal(t, 1, strings.Count(out, `"ipsec_metrics"`))
+}
+
+func TestMetricsCommand_WatchStopsOnClientErrors(t *testing.T) {
+	gatewayUUID := "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a"
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("GetGatewayMetrics", mock.Anything).Return(nil, &upcloud.Problem{Status: http.StatusNotFound, Title: "Gateway not found"})
+
+	c := commands.BuildCommand(MetricsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{gatewayUUID, "--watch", "1s"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	require.Error(t, err)
+	mService.AssertNumberOfCalls(t, "GetGatewayMetrics", 1)
+}
+
+func TestMetricsCommand_InvalidWatch(t *testing.T) {
+	conf := config.New()
+	mService := new(smock.Service)
+
+	c := commands.BuildCommand(MetricsCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a", "--watch", "100ms"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.EqualError(t, err, "invalid --watch value 100ms, interval must be at least 1s")
+}
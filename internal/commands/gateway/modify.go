@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "gateway modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a gateway",
+			"upctl gateway modify my-gateway --plan advanced",
+			"upctl gateway modify 8abc8009-4325-4b23-4321-b1232cd81231 --name my-renamed-gateway --label env=dev",
+			"upctl gateway modify my-gateway --configured-status stopped",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	name             string
+	plan             string
+	configuredStatus string
+	labels           []string
+	wait             config.OptionalBoolean
+	cfg              *config.Config
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "New name for the gateway.")
+	fs.StringVar(&c.plan, "plan", "", "New plan for the gateway. Run `upctl gateway plans` to list all available plans.")
+	fs.StringVar(&c.configuredStatus, "configured-status", "", "Configured status of the gateway. Valid values are "+namedargs.ValidValuesHelp(configuredStatuses...)+".")
+	fs.StringArrayVar(&c.labels, "label", nil, "Labels to describe the gateway in `key=value` format, multiple can be declared. Replaces the existing labels.")
+	config.AddToggleFlag(fs, &c.wait, "wait", false, "Wait for gateway to be in running state before returning. Gateways with stopped configured status are not waited for. Ignored in dry-run mode.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"name", "label"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("configured-status", cobra.FixedCompletions(configuredStatuses, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.cfg = cfg
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("plan", namedargs.CompletionFunc(completion.GatewayPlan{}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	req := request.ModifyGatewayRequest{
+		UUID:             uuid,
+		Name:             c.name,
+		Plan:             c.plan,
+		ConfiguredStatus: upcloud.GatewayConfiguredStatus(c.configuredStatus),
+	}
+
+	if len(c.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(c.labels)
+		if err != nil {
+			return nil, err
+		}
+		req.Labels = labelSlice
+	}
+
+	msg := fmt.Sprintf("Modifying gateway %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyGateway(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	// In dry-run mode, the gateway does not exist and there is nothing to wait for. Stopped gateways are not waited for, as the API does not define an operational state for them.
+	if c.wait.Value() && !c.cfg.DryRun() && res.ConfiguredStatus == upcloud.GatewayConfiguredStatusStarted {
+		waitForOperationalState(res.UUID, upcloud.GatewayOperationalStateRunning, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
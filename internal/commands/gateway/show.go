@@ -0,0 +1,134 @@
+package gateway
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ShowCommand creates the "gateway show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show gateway details",
+			"upctl gateway show 8abc8009-4325-4b23-4321-b1232cd81231",
+			"upctl gateway show my-gateway",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	gtw, err := exec.All().GetGateway(exec.Context(), &request.GetGatewayRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	routerRows := []output.TableRow{}
+	for _, router := range gtw.Routers {
+		routerRows = append(routerRows, output.TableRow{
+			router.UUID,
+			router.CreatedAt,
+		})
+	}
+
+	addressRows := []output.TableRow{}
+	for _, address := range gtw.Addresses {
+		addressRows = append(addressRows, output.TableRow{
+			address.Name,
+			address.Address,
+		})
+	}
+
+	connectionRows := []output.TableRow{}
+	for _, connection := range gtw.Connections {
+		connectionRows = append(connectionRows, output.TableRow{
+			connection.UUID,
+			connection.Name,
+			connection.Type,
+			len(connection.LocalRoutes),
+			len(connection.RemoteRoutes),
+			len(connection.Tunnels),
+		})
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows: []output.DetailRow{
+							{Title: "UUID:", Key: "uuid", Value: gtw.UUID, Colour: ui.DefaultUUUIDColours},
+							{Title: "Name:", Key: "name", Value: gtw.Name},
+							{Title: "Plan:", Key: "plan", Value: gtw.Plan},
+							{Title: "Zone:", Key: "zone", Value: gtw.Zone},
+							{Title: "Features:", Key: "features", Value: gtw.Features, Format: format.StringSliceAnd},
+							{Title: "Configured status:", Key: "configured_status", Value: gtw.ConfiguredStatus},
+							{Title: "Operational state:", Key: "operational_state", Value: gtw.OperationalState, Format: format.GatewayState},
+							{Title: "Created:", Key: "created_at", Value: gtw.CreatedAt},
+							{Title: "Updated:", Key: "updated_at", Value: gtw.UpdatedAt},
+						},
+					},
+				},
+			},
+		},
+		labels.GetLabelsSection(gtw.Labels),
+		output.CombinedSection{
+			Title: "Routers:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+					{Key: "created_at", Header: "Attached"},
+				},
+				Rows:         routerRows,
+				EmptyMessage: "No routers attached to this gateway.",
+			},
+		},
+		output.CombinedSection{
+			Title: "Addresses:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "address", Header: "Address", Colour: ui.DefaultAddressColours},
+				},
+				Rows:         addressRows,
+				EmptyMessage: "No addresses allocated for this gateway.",
+			},
+		},
+		output.CombinedSection{
+			Title: "Connections:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+					{Key: "name", Header: "Name"},
+					{Key: "type", Header: "Type"},
+					{Key: "local_routes", Header: "Local routes"},
+					{Key: "remote_routes", Header: "Remote routes"},
+					{Key: "tunnels", Header: "Tunnels"},
+				},
+				Rows:         connectionRows,
+				EmptyMessage: "No connections defined for this gateway.",
+			},
+		},
+	}
+
+	// For JSON and YAML output, passthrough API response
+	return output.MarshaledWithHumanOutput{
+		Value:  gtw,
+		Output: combined,
+	}, nil
+}
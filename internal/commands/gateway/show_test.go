@@ -0,0 +1,59 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowCommand(t *testing.T) {
+	text.DisableColors()
+
+	gateway := upcloud.Gateway{
+		UUID:             "10c4f2a9-6e0a-4ad8-9b2c-3f8e2e7d1f5a",
+		Name:             "my-gateway",
+		Zone:             "fi-hel1",
+		Plan:             "advanced",
+		Features:         []upcloud.GatewayFeature{upcloud.GatewayFeatureNAT, upcloud.GatewayFeatureVPN},
+		ConfiguredStatus: upcloud.GatewayConfiguredStatusStarted,
+		OperationalState: upcloud.GatewayOperationalStateRunning,
+		Routers:          []upcloud.GatewayRouter{{UUID: "04d031ab-4b85-4cbc-9f0e-6a2977541327"}},
+		Addresses:        []upcloud.GatewayAddress{{Name: "public-ip-1", Address: "94.237.0.10"}},
+		Connections: []upcloud.GatewayConnection{{
+			UUID:    "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+			Name:    "to-office",
+			Type:    upcloud.GatewayConnectionTypeIPSec,
+			Tunnels: []upcloud.GatewayTunnel{{Name: "tunnel-1"}},
+		}},
+	}
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("GetGateway", &request.GetGatewayRequest{UUID: gateway.UUID}).Return(&gateway, nil)
+
+	c := commands.BuildCommand(ShowCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{gateway.UUID})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	for _, expected := range []string{
+		"Name:              my-gateway",
+		"Features:          nat and vpn",
+		"Operational state: running",
+		"04d031ab-4b85-4cbc-9f0e-6a2977541327",
+		"public-ip-1",
+		"94.237.0.10",
+		"to-office",
+	} {
+		assert.Contains(t, out, expected)
+	}
+}
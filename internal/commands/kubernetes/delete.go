@@ -71,6 +71,12 @@ func Delete(exec commands.Executor, uuid string, wait bool) (output.Output, erro
 	return output.None{}, nil
 }
 
+// ResourceName implements commands.DestructiveCommand
+func (s *deleteCommand) ResourceName(uuid string) string {
+	cluster, _ := s.GetCached(uuid)
+	return cluster.Name
+}
+
 // Execute implements commands.MultipleArgumentCommand
 func (s *deleteCommand) Execute(exec commands.Executor, arg string) (output.Output, error) {
 	// Deprecating uks
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 
@@ -47,6 +48,11 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			cluster.NetworkCIDR,
 			cluster.Zone,
 			cluster.State,
+			cluster.Version,
+			cluster.Plan,
+			cluster.PrivateNodeGroups,
+			cluster.StorageEncryption,
+			labels.Cell(cluster.Labels),
 		})
 	}
 
@@ -60,6 +66,11 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "network_cidr", Header: "Network CIDR", Colour: ui.DefaultAddressColours},
 				{Key: "zone", Header: "Zone"},
 				{Key: "state", Header: "Operational state", Format: format.KubernetesClusterState},
+				{Key: "version", Header: "Version", Hidden: true},
+				{Key: "plan", Header: "Plan", Hidden: true},
+				{Key: "private_node_groups", Header: "Private node groups", Format: format.Boolean, Hidden: true},
+				{Key: "storage_encryption", Header: "Storage encryption", Hidden: true},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
@@ -0,0 +1,125 @@
+package loadbalancerbackend
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	healthCheckTypes = []string{
+		string(upcloud.LoadBalancerHealthCheckTypeTCP),
+		string(upcloud.LoadBalancerHealthCheckTypeHTTP),
+	}
+	proxyProtocolVersions = []string{
+		string(upcloud.LoadBalancerProxyProtocolVersion1),
+		string(upcloud.LoadBalancerProxyProtocolVersion2),
+	}
+)
+
+// BaseBackendCommand creates the base "load-balancer backend" command
+func BaseBackendCommand() commands.Command {
+	return &backendCommand{
+		commands.New("backend", "Manage load balancer backends"),
+	}
+}
+
+type backendCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *backendCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"backends"}
+}
+
+// propertiesParams contains the flags for the backend properties shared by create and modify commands.
+type propertiesParams struct {
+	timeoutServer             int
+	timeoutTunnel             int
+	healthCheckType           string
+	healthCheckInterval       int
+	healthCheckFall           int
+	healthCheckRise           int
+	healthCheckURL            string
+	healthCheckExpectedStatus int
+	healthCheckTLSVerify      config.OptionalBoolean
+	stickySessionCookieName   string
+	outboundProxyProtocol     string
+	tls                       config.OptionalBoolean
+	tlsVerify                 config.OptionalBoolean
+	tlsUseSystemCA            config.OptionalBoolean
+	http2                     config.OptionalBoolean
+}
+
+func (p *propertiesParams) addFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&p.timeoutServer, "timeout-server", 0, "Backend server timeout in seconds.")
+	fs.IntVar(&p.timeoutTunnel, "timeout-tunnel", 0, "Maximum inactivity time on the client and server side for tunnels in seconds.")
+	fs.StringVar(&p.healthCheckType, "health-check-type", "", "Health check type. Valid values are "+namedargs.ValidValuesHelp(healthCheckTypes...)+".")
+	fs.IntVar(&p.healthCheckInterval, "health-check-interval", 0, "Interval between health checks in seconds.")
+	fs.IntVar(&p.healthCheckFall, "health-check-fall", 0, "Number of failed health checks before the member is considered down.")
+	fs.IntVar(&p.healthCheckRise, "health-check-rise", 0, "Number of successful health checks before the member is considered up.")
+	fs.StringVar(&p.healthCheckURL, "health-check-url", "", "Target path for the HTTP health check.")
+	fs.IntVar(&p.healthCheckExpectedStatus, "health-check-expected-status", 0, "Expected HTTP status code of the HTTP health check.")
+	config.AddEnableDisableFlags(fs, &p.healthCheckTLSVerify, "health-check-tls-verify", "certificate verification of the HTTP health check")
+	fs.StringVar(&p.stickySessionCookieName, "sticky-session-cookie-name", "", "Name of the cookie used for sticky sessions.")
+	fs.StringVar(&p.outboundProxyProtocol, "outbound-proxy-protocol", "", "Outbound PROXY protocol version. Valid values are "+namedargs.ValidValuesHelp(proxyProtocolVersions...)+".")
+	config.AddEnableDisableFlags(fs, &p.tls, "tls", "TLS connections to the backend members")
+	config.AddEnableDisableFlags(fs, &p.tlsVerify, "tls-verify", "certificate verification of the backend members")
+	config.AddEnableDisableFlags(fs, &p.tlsUseSystemCA, "tls-use-system-ca", "using the system CA certificates for verifying the backend members")
+	config.AddEnableDisableFlags(fs, &p.http2, "http2", "HTTP/2 connections to the backend members")
+}
+
+func (p *propertiesParams) registerFlagCompletions(cmd *cobra.Command) {
+	for _, flag := range []string{
+		"timeout-server",
+		"timeout-tunnel",
+		"health-check-interval",
+		"health-check-fall",
+		"health-check-rise",
+		"health-check-url",
+		"health-check-expected-status",
+		"sticky-session-cookie-name",
+	} {
+		commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(cmd.RegisterFlagCompletionFunc("health-check-type", cobra.FixedCompletions(healthCheckTypes, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(cmd.RegisterFlagCompletionFunc("outbound-proxy-protocol", cobra.FixedCompletions(proxyProtocolVersions, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// properties returns the backend properties defined with flags or nil, if none of the properties were defined.
+func (p *propertiesParams) properties() *upcloud.LoadBalancerBackendProperties {
+	properties := upcloud.LoadBalancerBackendProperties{
+		TimeoutServer:             p.timeoutServer,
+		TimeoutTunnel:             p.timeoutTunnel,
+		HealthCheckType:           upcloud.LoadBalancerHealthCheckType(p.healthCheckType),
+		HealthCheckInterval:       p.healthCheckInterval,
+		HealthCheckFall:           p.healthCheckFall,
+		HealthCheckRise:           p.healthCheckRise,
+		HealthCheckURL:            p.healthCheckURL,
+		HealthCheckExpectedStatus: p.healthCheckExpectedStatus,
+		HealthCheckTLSVerify:      optionalBoolPtr(p.healthCheckTLSVerify),
+		StickySessionCookieName:   p.stickySessionCookieName,
+		OutboundProxyProtocol:     upcloud.LoadBalancerProxyProtocolVersion(p.outboundProxyProtocol),
+		TLSEnabled:                optionalBoolPtr(p.tls),
+		TLSVerify:                 optionalBoolPtr(p.tlsVerify),
+		TLSUseSystemCA:            optionalBoolPtr(p.tlsUseSystemCA),
+		HTTP2Enabled:              optionalBoolPtr(p.http2),
+	}
+
+	if properties == (upcloud.LoadBalancerBackendProperties{}) {
+		return nil
+	}
+	return &properties
+}
+
+func optionalBoolPtr(b config.OptionalBoolean) *bool {
+	if !b.IsSet() {
+		return nil
+	}
+	return upcloud.BoolPtr(b.Value())
+}
@@ -0,0 +1,78 @@
+package loadbalancerbackend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer backend create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a backend to a load balancer",
+			"upctl load-balancer backend create my-load-balancer --name web",
+			`upctl load-balancer backend create my-load-balancer \
+				--name web \
+				--health-check-type http \
+				--health-check-url /health \
+				--health-check-expected-status 200 \
+				--sticky-session-cookie-name SERVERID`,
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend    request.LoadBalancerBackend
+	properties propertiesParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.backend.Name, "name", "", "Backend name.")
+	fs.StringVar(&c.backend.Resolver, "resolver", "", "Name of the resolver used to resolve the addresses of dynamic members.")
+	c.properties.addFlags(fs)
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	for _, flag := range []string{"name", "resolver"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	c.properties.registerFlagCompletions(c.Cobra())
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *createCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	backend := c.backend
+	backend.Properties = c.properties.properties()
+	// Members must be defined as a list, even if it is empty
+	backend.Members = []request.LoadBalancerBackendMember{}
+
+	msg := fmt.Sprintf("Creating backend %s to load balancer %s", backend.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancerBackend(exec.Context(), &request.CreateLoadBalancerBackendRequest{
+		ServiceUUID: uuid,
+		Backend:     backend,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,90 @@
+package loadbalancerbackend
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateLoadBalancerBackendRequest
+		errorMsg string
+	}{
+		{
+			name:     "no name",
+			args:     []string{lbUUID},
+			errorMsg: `required flag(s) "name" not set`,
+		},
+		{
+			name: "only name",
+			args: []string{lbUUID, "--name", "web"},
+			expected: request.CreateLoadBalancerBackendRequest{
+				ServiceUUID: lbUUID,
+				Backend: request.LoadBalancerBackend{
+					Name:    "web",
+					Members: []request.LoadBalancerBackendMember{},
+				},
+			},
+		},
+		{
+			name: "health check and sticky sessions",
+			args: []string{
+				lbUUID,
+				"--name", "web",
+				"--resolver", "dns",
+				"--health-check-type", "http",
+				"--health-check-url", "/health",
+				"--health-check-expected-status", "200",
+				"--sticky-session-cookie-name", "SERVERID",
+				"--enable-http2",
+			},
+			expected: request.CreateLoadBalancerBackendRequest{
+				ServiceUUID: lbUUID,
+				Backend: request.LoadBalancerBackend{
+					Name:     "web",
+					Resolver: "dns",
+					Members:  []request.LoadBalancerBackendMember{},
+					Properties: &upcloud.LoadBalancerBackendProperties{
+						HealthCheckType:           upcloud.LoadBalancerHealthCheckTypeHTTP,
+						HealthCheckURL:            "/health",
+						HealthCheckExpectedStatus: 200,
+						StickySessionCookieName:   "SERVERID",
+						HTTP2Enabled:              upcloud.BoolPtr(true),
+					},
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("CreateLoadBalancerBackend", &expected).Return(&upcloud.LoadBalancerBackend{Name: "web"}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancerBackend", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package loadbalancerbackend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// DeleteCommand creates the "load-balancer backend delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a backend from a load balancer",
+			"upctl load-balancer backend delete my-load-balancer --name web",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *deleteCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Backend name.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("name", cobra.NoFileCompletions))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *deleteCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting backend %s from load balancer %s", c.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().DeleteLoadBalancerBackend(exec.Context(), &request.DeleteLoadBalancerBackendRequest{
+		ServiceUUID: uuid,
+		Name:        c.name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
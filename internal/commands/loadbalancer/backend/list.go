@@ -0,0 +1,75 @@
+package loadbalancerbackend
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// ListCommand creates the "load-balancer backend list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List backends of a load balancer",
+			"upctl load-balancer backend list 55199a44-4751-4e27-9394-7c7661910be3",
+			"upctl load-balancer backend list my-load-balancer",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	backends, err := exec.All().GetLoadBalancerBackends(exec.Context(), &request.GetLoadBalancerBackendsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, backend := range backends {
+		resolver := text.FgHiBlack.Sprint("None")
+		if backend.Resolver != "" {
+			resolver = backend.Resolver
+		}
+
+		enabled := 0
+		for _, member := range backend.Members {
+			if member.Enabled {
+				enabled++
+			}
+		}
+
+		rows = append(rows, output.TableRow{
+			backend.Name,
+			resolver,
+			len(backend.Members),
+			enabled,
+			len(backend.TLSConfigs),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: backends,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "resolver", Header: "Resolver"},
+				{Key: "members", Header: "Members"},
+				{Key: "enabled_members", Header: "Enabled members"},
+				{Key: "tls_configs", Header: "TLS configs"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No backends found for this load balancer.",
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+package loadbalancerbackendmember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// AddCommand creates the "load-balancer backend member add" command
+func AddCommand() commands.Command {
+	return &addCommand{
+		BaseCommand: commands.New(
+			"add",
+			"Add a member to a load balancer backend",
+			"upctl load-balancer backend member add my-load-balancer --backend web --name web-1 --server web-1.example.com --port 8080",
+			"upctl load-balancer backend member add my-load-balancer --backend web --name web-2 --ip 10.0.0.12 --port 8080 --weight 50",
+			"upctl load-balancer backend member add my-load-balancer --backend web --name web-3 --ip 10.0.0.13 --port 8080 --disabled",
+		),
+	}
+}
+
+type addCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend    string
+	server     string
+	memberType string
+	member     request.LoadBalancerBackendMember
+	disabled   config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (c *addCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.backend, "backend", "", "Name of the backend.")
+	fs.StringVar(&c.member.Name, "name", "", "Member name.")
+	fs.StringVar(&c.server, "server", "", "Server to add as a member. The IP address of the member is the IPv4 address of the server in the private network of the load balancer. The server can be defined with name or UUID.")
+	fs.StringVar(&c.member.IP, "ip", "", "IP address of the member.")
+	fs.IntVar(&c.member.Port, "port", 0, "Port of the member.")
+	fs.IntVar(&c.member.Weight, "weight", 100, "Weight of the member. Members with higher weight receive more traffic.")
+	fs.IntVar(&c.member.MaxSessions, "max-sessions", 1000, "Maximum number of sessions before queueing.")
+	fs.StringVar(&c.memberType, "type", string(upcloud.LoadBalancerBackendMemberTypeStatic), "Member type. Valid values are "+namedargs.ValidValuesHelp(memberTypes...)+".")
+	config.AddToggleFlag(fs, &c.disabled, "disabled", false, "Add the member in disabled state.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"backend", "name", "port"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	c.Cobra().MarkFlagsMutuallyExclusive("server", "ip")
+	for _, flag := range []string{"backend", "name", "ip", "port", "weight", "max-sessions"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("type", cobra.FixedCompletions(memberTypes, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *addCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("server", namedargs.CompletionFunc(completion.Server{}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *addCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	member := c.member
+	member.Type = upcloud.LoadBalancerBackendMemberType(c.memberType)
+	member.Enabled = !c.disabled.Value()
+
+	if c.server != "" {
+		ip, err := serverIP(exec, uuid, c.server)
+		if err != nil {
+			return nil, err
+		}
+		member.IP = ip
+	}
+	if member.Type == upcloud.LoadBalancerBackendMemberTypeStatic && member.IP == "" {
+		return nil, fmt.Errorf("either --server or --ip is required for static members")
+	}
+
+	msg := fmt.Sprintf("Adding member %s to backend %s of load balancer %s", member.Name, c.backend, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancerBackendMember(exec.Context(), &request.CreateLoadBalancerBackendMemberRequest{
+		ServiceUUID: uuid,
+		BackendName: c.backend,
+		Member:      member,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
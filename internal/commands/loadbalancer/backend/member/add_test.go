@@ -0,0 +1,120 @@
+package loadbalancerbackendmember
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+	networkUUID := "03a4b6d5-6a1d-4a43-8a86-cc5fbc6fbd6a"
+
+	lb := upcloud.LoadBalancer{
+		UUID: lbUUID,
+		Networks: []upcloud.LoadBalancerNetwork{
+			{Name: "public", Type: upcloud.LoadBalancerNetworkTypePublic},
+			{Name: "private", Type: upcloud.LoadBalancerNetworkTypePrivate, UUID: networkUUID},
+		},
+	}
+	server := upcloud.Server{UUID: "c4cb35bc-3fb5-4cce-9951-79cab2225417", Hostname: "web-1.example.com", Title: "web-1"}
+	servers := upcloud.Servers{Servers: []upcloud.Server{server}}
+	serverDetails := upcloud.ServerDetails{
+		Server: server,
+		Networking: upcloud.ServerNetworking{
+			Interfaces: upcloud.ServerInterfaceSlice{
+				{
+					Type:        upcloud.NetworkTypePublic,
+					IPAddresses: upcloud.IPAddressSlice{{Family: upcloud.IPAddressFamilyIPv4, Address: "94.237.0.10"}},
+				},
+				{
+					Type:        upcloud.NetworkTypePrivate,
+					Network:     networkUUID,
+					IPAddresses: upcloud.IPAddressSlice{{Family: upcloud.IPAddressFamilyIPv4, Address: "10.0.0.11"}},
+				},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateLoadBalancerBackendMemberRequest
+		errorMsg string
+	}{
+		{
+			name:     "no port",
+			args:     []string{lbUUID, "--backend", "web", "--name", "web-1", "--ip", "10.0.0.11"},
+			errorMsg: `required flag(s) "port" not set`,
+		},
+		{
+			name:     "no ip or server",
+			args:     []string{lbUUID, "--backend", "web", "--name", "web-1", "--port", "8080"},
+			errorMsg: "either --server or --ip is required for static members",
+		},
+		{
+			name: "with ip",
+			args: []string{lbUUID, "--backend", "web", "--name", "web-1", "--ip", "10.0.0.11", "--port", "8080", "--weight", "50", "--disabled"},
+			expected: request.CreateLoadBalancerBackendMemberRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Member: request.LoadBalancerBackendMember{
+					Name:        "web-1",
+					Type:        upcloud.LoadBalancerBackendMemberTypeStatic,
+					IP:          "10.0.0.11",
+					Port:        8080,
+					Weight:      50,
+					MaxSessions: 1000,
+					Enabled:     false,
+				},
+			},
+		},
+		{
+			name: "with server",
+			args: []string{lbUUID, "--backend", "web", "--name", "web-1", "--server", "web-1", "--port", "8080"},
+			expected: request.CreateLoadBalancerBackendMemberRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Member: request.LoadBalancerBackendMember{
+					Name:        "web-1",
+					Type:        upcloud.LoadBalancerBackendMemberTypeStatic,
+					IP:          "10.0.0.11",
+					Port:        8080,
+					Weight:      100,
+					MaxSessions: 1000,
+					Enabled:     true,
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			mService.On("GetServers").Return(&servers, nil)
+			mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: server.UUID}).Return(&serverDetails, nil)
+			mService.On("GetLoadBalancer", &request.GetLoadBalancerRequest{UUID: lbUUID}).Return(&lb, nil)
+			expected := test.expected
+			mService.On("CreateLoadBalancerBackendMember", &expected).Return(&upcloud.LoadBalancerBackendMember{Name: "web-1"}, nil)
+
+			c := commands.BuildCommand(AddCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancerBackendMember", 1)
+			}
+		})
+	}
+}
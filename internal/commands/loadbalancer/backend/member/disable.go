@@ -0,0 +1,117 @@
+package loadbalancerbackendmember
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// DisableCommand creates the "load-balancer backend member disable" command
+func DisableCommand() commands.Command {
+	return &disableCommand{
+		BaseCommand: commands.New(
+			"disable",
+			"Disable a load balancer backend member",
+			"upctl load-balancer backend member disable my-load-balancer --backend web --name web-1",
+			"upctl load-balancer backend member disable my-load-balancer --backend web --name web-1 --drain --drain-time 2m",
+		),
+	}
+}
+
+type disableCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend   string
+	name      string
+	drain     config.OptionalBoolean
+	drainTime time.Duration
+	cfg       *config.Config
+}
+
+// InitCommand implements Command.InitCommand
+func (c *disableCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.backend, "backend", "", "Name of the backend.")
+	fs.StringVar(&c.name, "name", "", "Member name.")
+	config.AddToggleFlag(fs, &c.drain, "drain", false, "Drain the member before disabling it. The weight of the member is set to zero so that it does not receive new sessions, and the member is disabled after the drain time has passed. The original weight is restored when the member is disabled.")
+	fs.DurationVar(&c.drainTime, "drain-time", 30*time.Second, "Time to wait for existing sessions to finish before disabling the member. Only applicable with `--drain` flag. Ignored in dry-run mode.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"backend", "name"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	for _, flag := range []string{"backend", "name", "drain-time"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *disableCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.cfg = cfg
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *disableCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Disabling member %s of backend %s of load balancer %s", c.name, c.backend, uuid)
+	exec.PushProgressStarted(msg)
+
+	member := request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(false)}
+	if c.drain.Value() {
+		current, err := exec.All().GetLoadBalancerBackendMember(exec.Context(), &request.GetLoadBalancerBackendMemberRequest{
+			ServiceUUID: uuid,
+			BackendName: c.backend,
+			Name:        c.name,
+		})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		// Restore the original weight when disabling the member, so that the member is ready to be enabled again.
+		member.Weight = upcloud.IntPtr(current.Weight)
+
+		// Interrupting the command exits immediately, so print the original weight before draining to allow restoring it manually.
+		restore := fmt.Sprintf("upctl load-balancer backend member modify %s --backend %s --name %s --weight %d", uuid, c.backend, c.name, current.Weight)
+		exec.PushProgressUpdate(messages.Update{
+			Message: fmt.Sprintf("Original weight of member %s of backend %s of load balancer %s is %d", c.name, c.backend, uuid, current.Weight),
+			Status:  messages.MessageStatusSuccess,
+			Details: "If draining is interrupted, restore the weight with: " + restore,
+		})
+
+		exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Draining member %s of backend %s of load balancer %s", c.name, c.backend, uuid))
+
+		_, err = exec.All().ModifyLoadBalancerBackendMember(exec.Context(), &request.ModifyLoadBalancerBackendMemberRequest{
+			ServiceUUID: uuid,
+			BackendName: c.backend,
+			Name:        c.name,
+			Member:      request.ModifyLoadBalancerBackendMember{Weight: upcloud.IntPtr(0)},
+		})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		// In dry-run mode, the weight is not changed and there are no sessions to wait for.
+		if !c.cfg.DryRun() {
+			exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting %s for sessions of member %s to finish", c.drainTime, c.name))
+			select {
+			case <-time.After(c.drainTime):
+			case <-exec.Context().Done():
+				return commands.HandleError(exec, msg, fmt.Errorf("draining was cancelled, the weight of the member is 0, restore it with: %s: %w", restore, exec.Context().Err()))
+			}
+		}
+		exec.PushProgressUpdateMessage(msg, msg)
+	}
+
+	return modifyMember(exec, uuid, c.backend, c.name, member, msg)
+}
@@ -0,0 +1,101 @@
+package loadbalancerbackendmember
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisableCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	drainReq := request.ModifyLoadBalancerBackendMemberRequest{
+		ServiceUUID: lbUUID,
+		BackendName: "web",
+		Name:        "web-1",
+		Member:      request.ModifyLoadBalancerBackendMember{Weight: upcloud.IntPtr(0)},
+	}
+	disableReq := request.ModifyLoadBalancerBackendMemberRequest{
+		ServiceUUID: lbUUID,
+		BackendName: "web",
+		Name:        "web-1",
+		Member:      request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(false)},
+	}
+	drainedDisableReq := request.ModifyLoadBalancerBackendMemberRequest{
+		ServiceUUID: lbUUID,
+		BackendName: "web",
+		Name:        "web-1",
+		Member:      request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(false), Weight: upcloud.IntPtr(50)},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		drained  bool
+		dryRun   bool
+		errorMsg string
+	}{
+		{
+			name:     "no backend",
+			args:     []string{lbUUID, "--name", "web-1"},
+			errorMsg: `required flag(s) "backend" not set`,
+		},
+		{
+			name: "disable",
+			args: []string{lbUUID, "--backend", "web", "--name", "web-1"},
+		},
+		{
+			name:    "drain and disable",
+			args:    []string{lbUUID, "--backend", "web", "--name", "web-1", "--drain", "--drain-time", "0s"},
+			drained: true,
+		},
+		{
+			name:    "drain in dry-run mode does not wait",
+			args:    []string{lbUUID, "--backend", "web", "--name", "web-1", "--drain", "--drain-time", "1h"},
+			drained: true,
+			dryRun:  true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			conf.Viper().Set(config.KeyDryRun, test.dryRun)
+			mService := new(smock.Service)
+
+			mService.On("ModifyLoadBalancerBackendMember", &drainReq).Return(&upcloud.LoadBalancerBackendMember{Name: "web-1"}, nil)
+			mService.On("ModifyLoadBalancerBackendMember", &disableReq).Return(&upcloud.LoadBalancerBackendMember{Name: "web-1"}, nil)
+			mService.On("ModifyLoadBalancerBackendMember", &drainedDisableReq).Return(&upcloud.LoadBalancerBackendMember{Name: "web-1"}, nil)
+			mService.On("GetLoadBalancerBackendMember", &request.GetLoadBalancerBackendMemberRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Name:        "web-1",
+			}).Return(&upcloud.LoadBalancerBackendMember{Name: "web-1", Weight: 50}, nil)
+
+			c := commands.BuildCommand(DisableCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			if test.drained {
+				mService.AssertCalled(t, "ModifyLoadBalancerBackendMember", &drainReq)
+				mService.AssertCalled(t, "ModifyLoadBalancerBackendMember", &drainedDisableReq)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerBackendMember", 2)
+			} else {
+				mService.AssertCalled(t, "ModifyLoadBalancerBackendMember", &disableReq)
+				mService.AssertNotCalled(t, "GetLoadBalancerBackendMember")
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerBackendMember", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package loadbalancerbackendmember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// EnableCommand creates the "load-balancer backend member enable" command
+func EnableCommand() commands.Command {
+	return &enableCommand{
+		BaseCommand: commands.New(
+			"enable",
+			"Enable a load balancer backend member",
+			"upctl load-balancer backend member enable my-load-balancer --backend web --name web-1",
+			"upctl load-balancer backend member enable my-load-balancer --backend web --name web-1 --weight 100",
+		),
+	}
+}
+
+type enableCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend string
+	name    string
+	weight  int
+}
+
+// InitCommand implements Command.InitCommand
+func (c *enableCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.backend, "backend", "", "Name of the backend.")
+	fs.StringVar(&c.name, "name", "", "Member name.")
+	fs.IntVar(&c.weight, "weight", 0, "Weight to set for the member.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"backend", "name"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	for _, flag := range []string{"backend", "name", "weight"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *enableCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	member := request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(true)}
+	if c.Cobra().Flags().Changed("weight") {
+		member.Weight = upcloud.IntPtr(c.weight)
+	}
+
+	msg := fmt.Sprintf("Enabling member %s of backend %s of load balancer %s", c.name, c.backend, uuid)
+	exec.PushProgressStarted(msg)
+
+	return modifyMember(exec, uuid, c.backend, c.name, member, msg)
+}
@@ -0,0 +1,73 @@
+package loadbalancerbackendmember
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEnableCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		config   map[string]any
+		expected request.ModifyLoadBalancerBackendMember
+	}{
+		{
+			name:     "enable",
+			args:     []string{lbUUID, "--backend", "web", "--name", "web-1"},
+			expected: request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(true)},
+		},
+		{
+			name:     "enable with weight",
+			args:     []string{lbUUID, "--backend", "web", "--name", "web-1", "--weight", "20"},
+			expected: request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(true), Weight: upcloud.IntPtr(20)},
+		},
+		{
+			name:     "enable with weight from config",
+			args:     []string{lbUUID, "--backend", "web", "--name", "web-1"},
+			config:   map[string]any{"enable.weight": 50},
+			expected: request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(true), Weight: upcloud.IntPtr(50)},
+		},
+		{
+			name:     "weight from command line takes precedence over config",
+			args:     []string{lbUUID, "--backend", "web", "--name", "web-1", "--weight", "0"},
+			config:   map[string]any{"enable.weight": 50},
+			expected: request.ModifyLoadBalancerBackendMember{Enabled: upcloud.BoolPtr(true), Weight: upcloud.IntPtr(0)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			for key, value := range test.config {
+				conf.Viper().Set(key, value)
+			}
+			mService := new(smock.Service)
+			mService.On("ModifyLoadBalancerBackendMember", mock.Anything).Return(&upcloud.LoadBalancerBackendMember{Name: "web-1"}, nil)
+
+			// Config key of the flag is the command path without the root command, i.e. enable.weight here.
+			parent := &cobra.Command{Use: "member"}
+			c := commands.BuildCommand(EnableCommand(), parent, conf)
+			parent.SetArgs(append([]string{"enable"}, test.args...))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+			assert.NoError(t, err)
+
+			mService.AssertCalled(t, "ModifyLoadBalancerBackendMember", &request.ModifyLoadBalancerBackendMemberRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Name:        "web-1",
+				Member:      test.expected,
+			})
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package loadbalancerbackendmember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+var memberTypes = []string{
+	string(upcloud.LoadBalancerBackendMemberTypeStatic),
+	string(upcloud.LoadBalancerBackendMemberTypeDynamic),
+}
+
+// BaseMemberCommand creates the base "load-balancer backend member" command
+func BaseMemberCommand() commands.Command {
+	return &memberCommand{
+		commands.New("member", "Manage load balancer backend members"),
+	}
+}
+
+type memberCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *memberCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"members"}
+}
+
+// serverIP returns the IPv4 address of the given server in one of the private networks of the load balancer. The server can be defined with name or UUID.
+func serverIP(exec commands.Executor, loadBalancerUUID, serverArg string) (string, error) {
+	serverUUID, err := namedargs.ResolveServer(exec, serverArg)
+	if err != nil {
+		return "", err
+	}
+
+	lb, err := exec.All().GetLoadBalancer(exec.Context(), &request.GetLoadBalancerRequest{UUID: loadBalancerUUID})
+	if err != nil {
+		return "", err
+	}
+
+	networks := make(map[string]bool)
+	for _, network := range lb.Networks {
+		if network.Type == upcloud.LoadBalancerNetworkTypePrivate && network.UUID != "" {
+			networks[network.UUID] = true
+		}
+	}
+	if lb.NetworkUUID != "" {
+		networks[lb.NetworkUUID] = true
+	}
+	if len(networks) == 0 {
+		return "", fmt.Errorf("load balancer %s is not attached to any private networks", loadBalancerUUID)
+	}
+
+	server, err := exec.All().GetServerDetails(exec.Context(), &request.GetServerDetailsRequest{UUID: serverUUID})
+	if err != nil {
+		return "", err
+	}
+
+	for _, iface := range server.Networking.Interfaces {
+		if !networks[iface.Network] {
+			continue
+		}
+		for _, ip := range iface.IPAddresses {
+			if ip.Family == upcloud.IPAddressFamilyIPv4 && ip.Address != "" {
+				return ip.Address, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("server %s does not have an IPv4 address in the private networks of load balancer %s", serverArg, loadBalancerUUID)
+}
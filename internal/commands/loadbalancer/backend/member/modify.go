@@ -0,0 +1,118 @@
+package loadbalancerbackendmember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer backend member modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer backend member",
+			"upctl load-balancer backend member modify my-load-balancer --backend web --name web-1 --weight 50",
+			"upctl load-balancer backend member modify my-load-balancer --backend web --name web-1 --server web-1-new.example.com",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend     string
+	name        string
+	server      string
+	ip          string
+	weight      int
+	maxSessions int
+	memberType  string
+	member      request.ModifyLoadBalancerBackendMember
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.backend, "backend", "", "Name of the backend.")
+	fs.StringVar(&c.name, "name", "", "Name of the member to modify.")
+	fs.StringVar(&c.member.Name, "new-name", "", "New name for the member.")
+	fs.StringVar(&c.server, "server", "", "Server to use as the member. The IP address of the member is set to the IPv4 address of the server in the private network of the load balancer. The server can be defined with name or UUID.")
+	fs.StringVar(&c.ip, "ip", "", "IP address of the member.")
+	fs.IntVar(&c.member.Port, "port", 0, "Port of the member.")
+	fs.IntVar(&c.weight, "weight", 0, "Weight of the member. Members with higher weight receive more traffic.")
+	fs.IntVar(&c.maxSessions, "max-sessions", 0, "Maximum number of sessions before queueing.")
+	fs.StringVar(&c.memberType, "type", "", "Member type. Valid values are "+namedargs.ValidValuesHelp(memberTypes...)+".")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"backend", "name"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	c.Cobra().MarkFlagsMutuallyExclusive("server", "ip")
+	for _, flag := range []string{"backend", "name", "new-name", "ip", "port", "weight", "max-sessions"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("type", cobra.FixedCompletions(memberTypes, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("server", namedargs.CompletionFunc(completion.Server{}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	flags := c.Cobra().Flags()
+	member := c.member
+	member.Type = upcloud.LoadBalancerBackendMemberType(c.memberType)
+	if flags.Changed("weight") {
+		member.Weight = upcloud.IntPtr(c.weight)
+	}
+	if flags.Changed("max-sessions") {
+		member.MaxSessions = upcloud.IntPtr(c.maxSessions)
+	}
+	if flags.Changed("ip") {
+		member.IP = upcloud.StringPtr(c.ip)
+	}
+	if c.server != "" {
+		ip, err := serverIP(exec, uuid, c.server)
+		if err != nil {
+			return nil, err
+		}
+		member.IP = &ip
+	}
+
+	msg := fmt.Sprintf("Modifying member %s of backend %s of load balancer %s", c.name, c.backend, uuid)
+	exec.PushProgressStarted(msg)
+
+	return modifyMember(exec, uuid, c.backend, c.name, member, msg)
+}
+
+// modifyMember modifies the backend member and completes the progress started with the given message.
+func modifyMember(exec commands.Executor, uuid, backend, name string, member request.ModifyLoadBalancerBackendMember, msg string) (output.Output, error) {
+	res, err := exec.All().ModifyLoadBalancerBackendMember(exec.Context(), &request.ModifyLoadBalancerBackendMemberRequest{
+		ServiceUUID: uuid,
+		BackendName: backend,
+		Name:        name,
+		Member:      member,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
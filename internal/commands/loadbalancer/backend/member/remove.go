@@ -0,0 +1,66 @@
+package loadbalancerbackendmember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// RemoveCommand creates the "load-balancer backend member remove" command
+func RemoveCommand() commands.Command {
+	return &removeCommand{
+		BaseCommand: commands.New(
+			"remove",
+			"Remove a member from a load balancer backend",
+			"upctl load-balancer backend member remove my-load-balancer --backend web --name web-1",
+		),
+	}
+}
+
+type removeCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend string
+	name    string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *removeCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.backend, "backend", "", "Name of the backend.")
+	fs.StringVar(&c.name, "name", "", "Member name.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"backend", "name"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *removeCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Removing member %s from backend %s of load balancer %s", c.name, c.backend, uuid)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().DeleteLoadBalancerBackendMember(exec.Context(), &request.DeleteLoadBalancerBackendMemberRequest{
+		ServiceUUID: uuid,
+		BackendName: c.backend,
+		Name:        c.name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
@@ -0,0 +1,87 @@
+package loadbalancerbackend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer backend modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer backend",
+			"upctl load-balancer backend modify my-load-balancer --name web --new-name www",
+			"upctl load-balancer backend modify my-load-balancer --name web --health-check-type tcp --sticky-session-cookie-name \"\"",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name       string
+	newName    string
+	resolver   string
+	properties propertiesParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Name of the backend to modify.")
+	fs.StringVar(&c.newName, "new-name", "", "New name for the backend.")
+	fs.StringVar(&c.resolver, "resolver", "", "Name of the resolver used to resolve the addresses of dynamic members. Use empty value to remove the resolver.")
+	c.properties.addFlags(fs)
+	fs.Lookup("sticky-session-cookie-name").Usage += " Use empty value to disable sticky sessions."
+	fs.Lookup("outbound-proxy-protocol").Usage += " Use empty value to disable outbound PROXY protocol."
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	for _, flag := range []string{"name", "new-name", "resolver"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	c.properties.registerFlagCompletions(c.Cobra())
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	flags := c.Cobra().Flags()
+	backend := request.ModifyLoadBalancerBackend{
+		Name:       c.newName,
+		Properties: c.properties.properties(),
+		ClearProperties: request.ModifyLoadBalancerBackendClearProperties{
+			StickySessionCookieName: flags.Changed("sticky-session-cookie-name") && c.properties.stickySessionCookieName == "",
+			OutboundProxyProtocol:   flags.Changed("outbound-proxy-protocol") && c.properties.outboundProxyProtocol == "",
+		},
+	}
+	if flags.Changed("resolver") {
+		backend.Resolver = &c.resolver
+	}
+
+	msg := fmt.Sprintf("Modifying backend %s of load balancer %s", c.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyLoadBalancerBackend(exec.Context(), &request.ModifyLoadBalancerBackendRequest{
+		ServiceUUID: uuid,
+		Name:        c.name,
+		Backend:     backend,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
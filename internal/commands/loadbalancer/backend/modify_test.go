@@ -0,0 +1,90 @@
+package loadbalancerbackend
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.ModifyLoadBalancerBackendRequest
+		errorMsg string
+	}{
+		{
+			name:     "no name",
+			args:     []string{lbUUID, "--new-name", "www"},
+			errorMsg: `required flag(s) "name" not set`,
+		},
+		{
+			name: "rename and set resolver",
+			args: []string{lbUUID, "--name", "web", "--new-name", "www", "--resolver", "dns"},
+			expected: request.ModifyLoadBalancerBackendRequest{
+				ServiceUUID: lbUUID,
+				Name:        "web",
+				Backend: request.ModifyLoadBalancerBackend{
+					Name:     "www",
+					Resolver: upcloud.StringPtr("dns"),
+				},
+			},
+		},
+		{
+			name: "remove resolver",
+			args: []string{lbUUID, "--name", "web", "--resolver", ""},
+			expected: request.ModifyLoadBalancerBackendRequest{
+				ServiceUUID: lbUUID,
+				Name:        "web",
+				Backend: request.ModifyLoadBalancerBackend{
+					Resolver: upcloud.StringPtr(""),
+				},
+			},
+		},
+		{
+			name: "properties and cleared sticky session",
+			args: []string{lbUUID, "--name", "web", "--health-check-type", "tcp", "--sticky-session-cookie-name", ""},
+			expected: request.ModifyLoadBalancerBackendRequest{
+				ServiceUUID: lbUUID,
+				Name:        "web",
+				Backend: request.ModifyLoadBalancerBackend{
+					Properties: &upcloud.LoadBalancerBackendProperties{
+						HealthCheckType: upcloud.LoadBalancerHealthCheckTypeTCP,
+					},
+					ClearProperties: request.ModifyLoadBalancerBackendClearProperties{
+						StickySessionCookieName: true,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("ModifyLoadBalancerBackend", &expected).Return(&upcloud.LoadBalancerBackend{Name: "web"}, nil)
+
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerBackend", 1)
+			}
+		})
+	}
+}
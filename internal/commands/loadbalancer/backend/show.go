@@ -0,0 +1,149 @@
+package loadbalancerbackend
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ShowCommand creates the "load-balancer backend show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show load balancer backend details",
+			"upctl load-balancer backend show my-load-balancer --name web",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *showCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Backend name.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("name", cobra.NoFileCompletions))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *showCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	backend, err := exec.All().GetLoadBalancerBackend(exec.Context(), &request.GetLoadBalancerBackendRequest{ServiceUUID: uuid, Name: c.name})
+	if err != nil {
+		return nil, err
+	}
+
+	overviewRows := []output.DetailRow{
+		{Title: "Name:", Value: backend.Name},
+		{Title: "Resolver:", Value: backend.Resolver, Format: format.PossiblyUnknownString},
+	}
+	if props := backend.Properties; props != nil {
+		overviewRows = append(overviewRows,
+			output.DetailRow{Title: "Server timeout:", Value: props.TimeoutServer},
+			output.DetailRow{Title: "Tunnel timeout:", Value: props.TimeoutTunnel},
+			output.DetailRow{Title: "Health check type:", Value: props.HealthCheckType},
+			output.DetailRow{Title: "Health check interval:", Value: props.HealthCheckInterval},
+			output.DetailRow{Title: "Health check fall:", Value: props.HealthCheckFall},
+			output.DetailRow{Title: "Health check rise:", Value: props.HealthCheckRise},
+			output.DetailRow{Title: "Health check URL:", Value: props.HealthCheckURL},
+			output.DetailRow{Title: "Health check expected status:", Value: props.HealthCheckExpectedStatus},
+			output.DetailRow{Title: "Sticky session cookie name:", Value: props.StickySessionCookieName},
+			output.DetailRow{Title: "Outbound proxy protocol:", Value: props.OutboundProxyProtocol},
+		)
+		for _, b := range []struct {
+			title string
+			value *bool
+		}{
+			{"Health check TLS verify:", props.HealthCheckTLSVerify},
+			{"TLS enabled:", props.TLSEnabled},
+			{"TLS verify:", props.TLSVerify},
+			{"TLS use system CA:", props.TLSUseSystemCA},
+			{"HTTP/2 enabled:", props.HTTP2Enabled},
+		} {
+			if b.value != nil {
+				overviewRows = append(overviewRows, output.DetailRow{Title: b.title, Value: *b.value, Format: format.Boolean})
+			}
+		}
+	}
+
+	memberRows := []output.TableRow{}
+	for _, member := range backend.Members {
+		memberRows = append(memberRows, output.TableRow{
+			member.Name,
+			member.Type,
+			member.IP,
+			member.Port,
+			member.Weight,
+			member.MaxSessions,
+			member.Enabled,
+		})
+	}
+
+	tlsConfigRows := []output.TableRow{}
+	for _, tlsConfig := range backend.TLSConfigs {
+		tlsConfigRows = append(tlsConfigRows, output.TableRow{
+			tlsConfig.Name,
+			tlsConfig.CertificateBundleUUID,
+		})
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows:  overviewRows,
+					},
+				},
+			},
+		},
+		output.CombinedSection{
+			Title: "Members:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "type", Header: "Type"},
+					{Key: "ip", Header: "IP", Colour: ui.DefaultAddressColours},
+					{Key: "port", Header: "Port"},
+					{Key: "weight", Header: "Weight"},
+					{Key: "max_sessions", Header: "Max sessions"},
+					{Key: "enabled", Header: "Enabled", Format: format.Boolean},
+				},
+				Rows: memberRows,
+			},
+		},
+		output.CombinedSection{
+			Title: "TLS configs:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "certificate_bundle_uuid", Header: "Certificate bundle UUID", Colour: ui.DefaultUUUIDColours},
+				},
+				Rows: tlsConfigRows,
+			},
+		},
+	}
+
+	// For JSON and YAML output, passthrough API response
+	return output.MarshaledWithHumanOutput{
+		Value:  backend,
+		Output: combined,
+	}, nil
+}
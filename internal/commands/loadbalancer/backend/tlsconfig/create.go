@@ -0,0 +1,78 @@
+package loadbalancerbackendtlsconfig
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer backend tls-config create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Attach a certificate bundle to a load balancer backend",
+			"upctl load-balancer backend tls-config create my-load-balancer --backend web --name example-com --certificate-bundle example-com",
+		),
+		params: newTLSConfigParams(),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	params loadbalancer.TLSConfigParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	c.params.AddParentFlag(fs)
+	c.params.AddNameFlag(fs, "TLS config name.")
+	c.params.AddCertificateBundleFlag(fs)
+	c.AddFlags(fs)
+
+	c.params.RegisterFlags(c.Cobra(), "backend", "name", "certificate-bundle")
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.params.RegisterCertificateBundleCompletion(c.Cobra(), cfg)
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *createCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	certificateBundleUUID, err := c.params.ResolveCertificateBundle(exec)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating TLS config %s for backend %s of load balancer %s", c.params.Name, c.params.Parent, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancerBackendTLSConfig(exec.Context(), &request.CreateLoadBalancerBackendTLSConfigRequest{
+		ServiceUUID: uuid,
+		BackendName: c.params.Parent,
+		Config: request.LoadBalancerBackendTLSConfig{
+			Name:                  c.params.Name,
+			CertificateBundleUUID: certificateBundleUUID,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,86 @@
+package loadbalancerbackendtlsconfig
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+	certBundles := []upcloud.LoadBalancerCertificateBundle{
+		{UUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2", Name: "example-com"},
+		{UUID: "0a2a4b1c-7c5f-4b43-9b56-2d9f1b0e2d1e", Name: "example-org"},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateLoadBalancerBackendTLSConfigRequest
+		errorMsg string
+	}{
+		{
+			name:     "no certificate bundle",
+			args:     []string{lbUUID, "--backend", "web", "--name", "example-com"},
+			errorMsg: `required flag(s) "certificate-bundle" not set`,
+		},
+		{
+			name:     "unknown certificate bundle",
+			args:     []string{lbUUID, "--backend", "web", "--name", "example-com", "--certificate-bundle", "example-net"},
+			errorMsg: "could not resolve certificate bundle: nothing found matching 'example-net'",
+		},
+		{
+			name: "certificate bundle by name",
+			args: []string{lbUUID, "--backend", "web", "--name", "example-com", "--certificate-bundle", "example-com"},
+			expected: request.CreateLoadBalancerBackendTLSConfigRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Config: request.LoadBalancerBackendTLSConfig{
+					Name:                  "example-com",
+					CertificateBundleUUID: certBundles[0].UUID,
+				},
+			},
+		},
+		{
+			name: "certificate bundle by UUID",
+			args: []string{lbUUID, "--backend", "web", "--name", "example-org", "--certificate-bundle", certBundles[1].UUID},
+			expected: request.CreateLoadBalancerBackendTLSConfigRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Config: request.LoadBalancerBackendTLSConfig{
+					Name:                  "example-org",
+					CertificateBundleUUID: certBundles[1].UUID,
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			mService.On("GetLoadBalancerCertificateBundles", mock.Anything).Return(certBundles, nil)
+			expected := test.expected
+			mService.On("CreateLoadBalancerBackendTLSConfig", &expected).Return(&upcloud.LoadBalancerBackendTLSConfig{Name: expected.Config.Name}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancerBackendTLSConfig", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package loadbalancerbackendtlsconfig
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCommand(t *testing.T) {
+	text.DisableColors()
+
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+	tlsConfigs := []upcloud.LoadBalancerBackendTLSConfig{
+		{Name: "example-com", CertificateBundleUUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2"},
+	}
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancerBackendTLSConfigs", &request.GetLoadBalancerBackendTLSConfigsRequest{
+		ServiceUUID: lbUUID,
+		BackendName: "web",
+	}).Return(tlsConfigs, nil)
+
+	c := commands.BuildCommand(ListCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{lbUUID, "--backend", "web"})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "example-com")
+	assert.Contains(t, out, "0aded5c1-c7a3-498a-b9c8-a871611c47a2")
+}
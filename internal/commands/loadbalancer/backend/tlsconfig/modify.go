@@ -0,0 +1,80 @@
+package loadbalancerbackendtlsconfig
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer backend tls-config modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a TLS config of a load balancer backend",
+			"upctl load-balancer backend tls-config modify my-load-balancer --backend web --name example-com --certificate-bundle example-com-renewed",
+		),
+		params: newTLSConfigParams(),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	params loadbalancer.TLSConfigParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	c.params.AddParentFlag(fs)
+	c.params.AddNameFlag(fs, "Name of the TLS config to modify.")
+	c.params.AddNewNameFlag(fs)
+	c.params.AddCertificateBundleFlag(fs)
+	c.AddFlags(fs)
+
+	c.params.RegisterFlags(c.Cobra(), "backend", "name")
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	c.params.RegisterCertificateBundleCompletion(c.Cobra(), cfg)
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	certificateBundleUUID, err := c.params.ResolveCertificateBundle(exec)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Modifying TLS config %s of backend %s of load balancer %s", c.params.Name, c.params.Parent, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyLoadBalancerBackendTLSConfig(exec.Context(), &request.ModifyLoadBalancerBackendTLSConfigRequest{
+		ServiceUUID: uuid,
+		BackendName: c.params.Parent,
+		Name:        c.params.Name,
+		Config: request.LoadBalancerBackendTLSConfig{
+			Name:                  c.params.NewName,
+			CertificateBundleUUID: certificateBundleUUID,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,81 @@
+package loadbalancerbackendtlsconfig
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+	certBundles := []upcloud.LoadBalancerCertificateBundle{
+		{UUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2", Name: "example-com"},
+		{UUID: "0a2a4b1c-7c5f-4b43-9b56-2d9f1b0e2d1e", Name: "example-com-renewed"},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.ModifyLoadBalancerBackendTLSConfigRequest
+		errorMsg string
+	}{
+		{
+			name:     "no name",
+			args:     []string{lbUUID, "--backend", "web", "--certificate-bundle", "example-com-renewed"},
+			errorMsg: `required flag(s) "name" not set`,
+		},
+		{
+			name: "certificate bundle",
+			args: []string{lbUUID, "--backend", "web", "--name", "example-com", "--certificate-bundle", "example-com-renewed"},
+			expected: request.ModifyLoadBalancerBackendTLSConfigRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Name:        "example-com",
+				Config: request.LoadBalancerBackendTLSConfig{
+					CertificateBundleUUID: certBundles[1].UUID,
+				},
+			},
+		},
+		{
+			name: "new name",
+			args: []string{lbUUID, "--backend", "web", "--name", "example-com", "--new-name", "example-com-old"},
+			expected: request.ModifyLoadBalancerBackendTLSConfigRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Name:        "example-com",
+				Config: request.LoadBalancerBackendTLSConfig{
+					Name: "example-com-old",
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			mService.On("GetLoadBalancerCertificateBundles", mock.Anything).Return(certBundles, nil)
+			expected := test.expected
+			mService.On("ModifyLoadBalancerBackendTLSConfig", &expected).Return(&upcloud.LoadBalancerBackendTLSConfig{Name: expected.Name}, nil)
+
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerBackendTLSConfig", 1)
+			}
+		})
+	}
+}
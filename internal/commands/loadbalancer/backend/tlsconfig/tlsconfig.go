@@ -0,0 +1,29 @@
+package loadbalancerbackendtlsconfig
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+)
+
+// BaseTLSConfigCommand creates the base "load-balancer backend tls-config" command
+func BaseTLSConfigCommand() commands.Command {
+	return &tlsConfigCommand{
+		commands.New("tls-config", "Manage load balancer backend TLS configs"),
+	}
+}
+
+type tlsConfigCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *tlsConfigCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"tls-configs"}
+}
+
+func newTLSConfigParams() loadbalancer.TLSConfigParams {
+	return loadbalancer.TLSConfigParams{
+		ParentType:            "backend",
+		CertificateBundleHelp: "Use `authority` bundles to verify the certificates of the backend members and `manual` bundles to define client certificates.",
+	}
+}
@@ -0,0 +1,97 @@
+package loadbalancercertificatebundle
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+)
+
+var (
+	bundleTypes = []string{
+		string(upcloud.LoadBalancerCertificateBundleTypeManual),
+		string(upcloud.LoadBalancerCertificateBundleTypeDynamic),
+		string(upcloud.LoadBalancerCertificateBundleTypeAuthority),
+	}
+	keyTypes = []string{"rsa", "ecdsa"}
+)
+
+// BaseCertificateBundleCommand creates the base "load-balancer certificate-bundle" command
+func BaseCertificateBundleCommand() commands.Command {
+	return &certificateBundleCommand{
+		commands.New("certificate-bundle", "Manage load balancer certificate bundles"),
+	}
+}
+
+type certificateBundleCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *certificateBundleCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"certificate-bundles", "cert-bundle"}
+}
+
+// readPEMFile reads the PEM encoded contents of the given file and returns them base64 encoded, as expected by the API. Empty path returns empty string.
+func readPEMFile(flag, path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read --%s file: %w", flag, err)
+	}
+	if block, _ := pem.Decode(data); block == nil {
+		return "", fmt.Errorf("--%s file %s does not contain PEM encoded data", flag, path)
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// parseCertificate decodes the first certificate in the given base64 encoded PEM data.
+func parseCertificate(data string) (*x509.Certificate, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		// Accept also PEM data that is not base64 encoded
+		raw = []byte(data)
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("certificate is not PEM encoded")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// parseExpiringWithin parses durations accepted by time.ParseDuration and also days, e.g. `30d`.
+func parseExpiringWithin(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid --expiring-within value %q", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid --expiring-within value %q", value)
+	}
+	return d, nil
+}
+
+// formatTime formats the given time as RFC3339 string. Zero time is formatted as empty string.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
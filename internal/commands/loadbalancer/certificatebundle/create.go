@@ -0,0 +1,121 @@
+package loadbalancercertificatebundle
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer certificate-bundle create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a load balancer certificate bundle",
+			"upctl load-balancer certificate-bundle create --name example-com --type manual --certificate cert.pem --intermediates chain.pem --private-key key.pem",
+			"upctl load-balancer certificate-bundle create --name example-com --type dynamic --hostname example.com --hostname www.example.com --key-type ecdsa",
+			"upctl load-balancer certificate-bundle create --name internal-ca --type authority --certificate ca.pem",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+
+	name          string
+	bundleType    string
+	certificate   string
+	intermediates string
+	privateKey    string
+	keyType       string
+	hostnames     []string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Certificate bundle name.")
+	fs.StringVar(&c.bundleType, "type", string(upcloud.LoadBalancerCertificateBundleTypeManual), "Certificate bundle type. Valid values are "+namedargs.ValidValuesHelp(bundleTypes...)+".")
+	fs.StringVar(&c.certificate, "certificate", "", "Path to a PEM encoded certificate file. Required for `manual` and `authority` bundles.")
+	fs.StringVar(&c.intermediates, "intermediates", "", "Path to a PEM encoded file containing the intermediate certificates. Only applicable for `manual` bundles.")
+	fs.StringVar(&c.privateKey, "private-key", "", "Path to a PEM encoded private key file. Required for `manual` bundles.")
+	fs.StringArrayVar(&c.hostnames, "hostname", nil, "Hostname to request the certificate for, multiple can be declared. Required for `dynamic` bundles.")
+	fs.StringVar(&c.keyType, "key-type", "", "Private key type. Valid values are "+namedargs.ValidValuesHelp(keyTypes...)+". Required for `dynamic` bundles.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	for _, flag := range []string{"name", "hostname"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("type", cobra.FixedCompletions(bundleTypes, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("key-type", cobra.FixedCompletions(keyTypes, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+func (c *createCommand) buildRequest() (*request.CreateLoadBalancerCertificateBundleRequest, error) {
+	req := request.CreateLoadBalancerCertificateBundleRequest{
+		Type: upcloud.LoadBalancerCertificateBundleType(c.bundleType),
+		Name: c.name,
+	}
+
+	switch req.Type {
+	case upcloud.LoadBalancerCertificateBundleTypeManual:
+		if c.certificate == "" || c.privateKey == "" {
+			return nil, fmt.Errorf("--certificate and --private-key are required for manual certificate bundles")
+		}
+	case upcloud.LoadBalancerCertificateBundleTypeAuthority:
+		if c.certificate == "" {
+			return nil, fmt.Errorf("--certificate is required for authority certificate bundles")
+		}
+	case upcloud.LoadBalancerCertificateBundleTypeDynamic:
+		if len(c.hostnames) == 0 || c.keyType == "" {
+			return nil, fmt.Errorf("--hostname and --key-type are required for dynamic certificate bundles")
+		}
+		req.Hostnames = c.hostnames
+		req.KeyType = c.keyType
+		return &req, nil
+	default:
+		return nil, fmt.Errorf("invalid certificate bundle type %q", c.bundleType)
+	}
+
+	var err error
+	if req.Certificate, err = readPEMFile("certificate", c.certificate); err != nil {
+		return nil, err
+	}
+	if req.Intermediates, err = readPEMFile("intermediates", c.intermediates); err != nil {
+		return nil, err
+	}
+	if req.PrivateKey, err = readPEMFile("private-key", c.privateKey); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (c *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	msg := fmt.Sprintf("Creating certificate bundle %s", c.name)
+	exec.PushProgressStarted(msg)
+
+	req, err := c.buildRequest()
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateLoadBalancerCertificateBundle(exec.Context(), req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
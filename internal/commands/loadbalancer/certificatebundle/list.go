@@ -0,0 +1,124 @@
+package loadbalancercertificatebundle
+
+import (
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/paging"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// allPagesSize is the page size used when paging through all certificate bundles
+const allPagesSize = 100
+
+// ListCommand creates the "load-balancer certificate-bundle list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List load balancer certificate bundles",
+			"upctl load-balancer certificate-bundle list",
+			"upctl load-balancer certificate-bundle list --expiring-within 30d",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	paging.PageParameters
+
+	expiringWithin string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *listCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	c.ConfigureFlags(fs)
+	fs.StringVar(&c.expiringWithin, "expiring-within", "", "Only list certificate bundles that expire within the given duration, e.g. `30d` or `12h`. All certificate bundles are searched, --limit and --page are ignored.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("expiring-within", cobra.NoFileCompletions))
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	var expiresBefore time.Time
+	if c.expiringWithin != "" {
+		d, err := parseExpiringWithin(c.expiringWithin)
+		if err != nil {
+			return nil, err
+		}
+		expiresBefore = time.Now().Add(d)
+	}
+
+	var certBundles []upcloud.LoadBalancerCertificateBundle
+	var err error
+	if expiresBefore.IsZero() {
+		certBundles, err = exec.All().GetLoadBalancerCertificateBundles(exec.Context(), &request.GetLoadBalancerCertificateBundlesRequest{
+			Page: c.Page(),
+		})
+	} else {
+		certBundles, err = getAllCertificateBundles(exec)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []upcloud.LoadBalancerCertificateBundle{}
+	rows := []output.TableRow{}
+	for _, cb := range certBundles {
+		if !expiresBefore.IsZero() && (cb.NotAfter.IsZero() || cb.NotAfter.After(expiresBefore)) {
+			continue
+		}
+
+		filtered = append(filtered, cb)
+		rows = append(rows, output.TableRow{
+			cb.UUID,
+			cb.Name,
+			cb.Type,
+			cb.OperationalState,
+			formatTime(cb.NotAfter),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: filtered,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				{Key: "name", Header: "Name"},
+				{Key: "type", Header: "Type"},
+				{Key: "operational_state", Header: "State"},
+				{Key: "not_after", Header: "Expires"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No certificate bundles found.",
+		},
+	}, nil
+}
+
+// getAllCertificateBundles pages through all certificate bundles, so that filtering does not miss bundles on later pages.
+func getAllCertificateBundles(exec commands.Executor) ([]upcloud.LoadBalancerCertificateBundle, error) {
+	var certBundles []upcloud.LoadBalancerCertificateBundle
+	page := &request.Page{Size: allPagesSize, Number: 1}
+	for {
+		certBundlesPage, err := exec.All().GetLoadBalancerCertificateBundles(exec.Context(), &request.GetLoadBalancerCertificateBundlesRequest{
+			Page: page,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		certBundles = append(certBundles, certBundlesPage...)
+		if len(certBundlesPage) < page.Size {
+			return certBundles, nil
+		}
+		page = page.Next()
+	}
+}
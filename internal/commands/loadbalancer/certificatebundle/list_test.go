@@ -0,0 +1,112 @@
+package loadbalancercertificatebundle
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCommand_ExpiringWithin(t *testing.T) {
+	certBundles := []upcloud.LoadBalancerCertificateBundle{
+		{UUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2", Name: "expiring", NotAfter: time.Now().Add(10 * 24 * time.Hour)},
+		{UUID: "0a2a4b1c-7c5f-4b43-9b56-2d9f1b0e2d1e", Name: "valid", NotAfter: time.Now().Add(90 * 24 * time.Hour)},
+		{UUID: "0ab2d0a2-3ee4-4d5c-9e0e-6b0c4d6f0b3a", Name: "pending", Type: upcloud.LoadBalancerCertificateBundleTypeDynamic},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected []string
+		errorMsg string
+	}{
+		{
+			name:     "all",
+			args:     []string{},
+			expected: []string{"expiring", "valid", "pending"},
+		},
+		{
+			name:     "days",
+			args:     []string{"--expiring-within", "30d"},
+			expected: []string{"expiring"},
+		},
+		{
+			name:     "hours",
+			args:     []string{"--expiring-within", "2400h"},
+			expected: []string{"expiring", "valid"},
+		},
+		{
+			name:     "invalid",
+			args:     []string{"--expiring-within", "month"},
+			errorMsg: `invalid --expiring-within value "month"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+			mService := new(smock.Service)
+			mService.On("GetLoadBalancerCertificateBundles", mock.Anything).Return(certBundles, nil)
+
+			c := commands.BuildCommand(ListCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			for _, cb := range certBundles {
+				if slices.Contains(test.expected, cb.Name) {
+					assert.Contains(t, out, cb.UUID)
+				} else {
+					assert.NotContains(t, out, cb.UUID)
+				}
+			}
+		})
+	}
+}
+
+func TestListCommand_ExpiringWithinPagesThroughAll(t *testing.T) {
+	firstPage := make([]upcloud.LoadBalancerCertificateBundle, allPagesSize)
+	for i := range firstPage {
+		firstPage[i] = upcloud.LoadBalancerCertificateBundle{
+			UUID:     fmt.Sprintf("0aded5c1-c7a3-498a-b9c8-%012d", i),
+			Name:     fmt.Sprintf("valid-%d", i),
+			NotAfter: time.Now().Add(90 * 24 * time.Hour),
+		}
+	}
+	expiring := upcloud.LoadBalancerCertificateBundle{UUID: "0a2a4b1c-7c5f-4b43-9b56-2d9f1b0e2d1e", Name: "expiring", NotAfter: time.Now().Add(10 * 24 * time.Hour)}
+
+	isPage := func(number int) func(*request.GetLoadBalancerCertificateBundlesRequest) bool {
+		return func(r *request.GetLoadBalancerCertificateBundlesRequest) bool {
+			return r.Page.Number == number && r.Page.Size == allPagesSize
+		}
+	}
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancerCertificateBundles", mock.MatchedBy(isPage(1))).Return(firstPage, nil)
+	mService.On("GetLoadBalancerCertificateBundles", mock.MatchedBy(isPage(2))).Return([]upcloud.LoadBalancerCertificateBundle{expiring}, nil)
+
+	c := commands.BuildCommand(ListCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"--expiring-within", "30d", "--limit", "10"})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	mService.AssertNumberOfCalls(t, "GetLoadBalancerCertificateBundles", 2)
+	assert.Contains(t, out, expiring.UUID)
+	assert.NotContains(t, out, firstPage[0].UUID)
+}
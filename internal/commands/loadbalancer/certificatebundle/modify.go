@@ -0,0 +1,90 @@
+package loadbalancercertificatebundle
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer certificate-bundle modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer certificate bundle",
+			"upctl load-balancer certificate-bundle modify example-com --certificate cert.pem --intermediates chain.pem --private-key key.pem",
+			"upctl load-balancer certificate-bundle modify example-com --hostname example.com --hostname api.example.com",
+			"upctl load-balancer certificate-bundle modify example-com --name example-com-old",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingCertificateBundle
+	completion.CertificateBundle
+
+	name          string
+	certificate   string
+	intermediates string
+	privateKey    string
+	hostnames     []string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "New name for the certificate bundle.")
+	fs.StringVar(&c.certificate, "certificate", "", "Path to a PEM encoded certificate file. Only applicable for `manual` and `authority` bundles.")
+	fs.StringVar(&c.intermediates, "intermediates", "", "Path to a PEM encoded file containing the intermediate certificates. Use empty value to remove the intermediate certificates. Only applicable for `manual` bundles.")
+	fs.StringVar(&c.privateKey, "private-key", "", "Path to a PEM encoded private key file. Only applicable for `manual` bundles.")
+	fs.StringArrayVar(&c.hostnames, "hostname", nil, "Hostname to request the certificate for, multiple can be declared. Replaces the current hostnames. Only applicable for `dynamic` bundles.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"name", "hostname"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying certificate bundle %s", uuid)
+	exec.PushProgressStarted(msg)
+
+	req := request.ModifyLoadBalancerCertificateBundleRequest{
+		UUID:      uuid,
+		Name:      c.name,
+		Hostnames: c.hostnames,
+	}
+
+	var err error
+	if req.Certificate, err = readPEMFile("certificate", c.certificate); err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	if req.PrivateKey, err = readPEMFile("private-key", c.privateKey); err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	if c.Cobra().Flags().Changed("intermediates") {
+		intermediates, err := readPEMFile("intermediates", c.intermediates)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		req.Intermediates = &intermediates
+	}
+
+	res, err := exec.All().ModifyLoadBalancerCertificateBundle(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,111 @@
+package loadbalancercertificatebundle
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ShowCommand creates the "load-balancer certificate-bundle show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show load balancer certificate bundle details",
+			"upctl load-balancer certificate-bundle show 0aded5c1-c7a3-498a-b9c8-a871611c47a2",
+			"upctl load-balancer certificate-bundle show my-certificate-bundle",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingCertificateBundle
+	completion.CertificateBundle
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	cb, err := exec.All().GetLoadBalancerCertificateBundle(exec.Context(), &request.GetLoadBalancerCertificateBundleRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows: []output.DetailRow{
+							{Title: "UUID:", Value: cb.UUID, Colour: ui.DefaultUUUIDColours},
+							{Title: "Name:", Value: cb.Name},
+							{Title: "Type:", Value: cb.Type},
+							{Title: "Operational state:", Value: cb.OperationalState},
+							{Title: "Key type:", Value: cb.KeyType, Format: format.PossiblyUnknownString},
+							{Title: "Hostnames:", Value: cb.Hostnames, Format: format.StringSliceSingleLineAnd},
+							{Title: "Not before:", Value: formatTime(cb.NotBefore)},
+							{Title: "Not after:", Value: formatTime(cb.NotAfter)},
+							{Title: "Created:", Value: formatTime(cb.CreatedAt)},
+							{Title: "Updated:", Value: formatTime(cb.UpdatedAt)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Certificate is not available until dynamic certificate bundles have been issued
+	if cb.Certificate != "" {
+		section, err := certificateSection(cb.Certificate)
+		if err != nil {
+			exec.PushProgressUpdate(messages.Update{
+				Message: "Parsing certificate failed. Certificate bundle is displayed without certificate details",
+				Status:  messages.MessageStatusWarning,
+				Details: "Error: " + err.Error(),
+			})
+		} else {
+			combined = append(combined, section)
+		}
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value:  cb,
+		Output: combined,
+	}, nil
+}
+
+func certificateSection(certificate string) (output.CombinedSection, error) {
+	cert, err := parseCertificate(certificate)
+	if err != nil {
+		return output.CombinedSection{}, err
+	}
+
+	sans := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return output.CombinedSection{
+		Contents: output.Details{
+			Sections: []output.DetailSection{
+				{
+					Title: "Certificate:",
+					Rows: []output.DetailRow{
+						{Title: "Subject:", Value: cert.Subject.String()},
+						{Title: "Issuer:", Value: cert.Issuer.String()},
+						{Title: "Subject alternative names:", Value: sans, Format: format.StringSliceSingleLineAnd},
+						{Title: "Not before:", Value: formatTime(cert.NotBefore)},
+						{Title: "Expires:", Value: formatTime(cert.NotAfter)},
+					},
+				},
+			},
+		},
+	}, nil
+}
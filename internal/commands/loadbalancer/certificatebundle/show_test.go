@@ -0,0 +1,87 @@
+package loadbalancercertificatebundle
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowCommand(t *testing.T) {
+	text.DisableColors()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	certBundle := upcloud.LoadBalancerCertificateBundle{
+		UUID:        "0aded5c1-c7a3-498a-b9c8-a871611c47a2",
+		Name:        "example-com",
+		Type:        upcloud.LoadBalancerCertificateBundleTypeManual,
+		Certificate: base64.StdEncoding.EncodeToString(certPEM),
+		NotAfter:    notAfter,
+	}
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancerCertificateBundle", &request.GetLoadBalancerCertificateBundleRequest{UUID: certBundle.UUID}).Return(&certBundle, nil)
+
+	c := commands.BuildCommand(ShowCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{certBundle.UUID})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "CN=example.com")
+	assert.Contains(t, out, "example.com and www.example.com")
+	assert.Contains(t, out, "2030-01-02T03:04:05Z")
+}
+
+func TestShowCommand_InvalidCertificate(t *testing.T) {
+	text.DisableColors()
+
+	certBundle := upcloud.LoadBalancerCertificateBundle{
+		UUID:        "0aded5c1-c7a3-498a-b9c8-a871611c47a2",
+		Name:        "broken-certificate",
+		Type:        upcloud.LoadBalancerCertificateBundleTypeManual,
+		Certificate: base64.StdEncoding.EncodeToString([]byte("not a certificate")),
+	}
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancerCertificateBundle", &request.GetLoadBalancerCertificateBundleRequest{UUID: certBundle.UUID}).Return(&certBundle, nil)
+
+	c := commands.BuildCommand(ShowCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{certBundle.UUID})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "broken-certificate")
+	assert.NotContains(t, out, "Certificate:")
+}
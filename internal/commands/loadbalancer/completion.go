@@ -0,0 +1,37 @@
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// NetworkNames returns the names of the networks of the given load balancer. Use with namedargs.ResolvedArgumentCompletionFunc to complete network names.
+func NetworkNames(ctx context.Context, svc service.AllServices, uuid string) ([]string, error) {
+	lb, err := svc.GetLoadBalancer(ctx, &request.GetLoadBalancerRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, network := range lb.Networks {
+		names = append(names, network.Name)
+	}
+	return names, nil
+}
+
+// IPAddresses returns the floating IP addresses attached to the given load balancer. Use with namedargs.ResolvedArgumentCompletionFunc to complete attached addresses.
+func IPAddresses(ctx context.Context, svc service.AllServices, uuid string) ([]string, error) {
+	ipAddresses, err := svc.GetLoadBalancerIPAddresses(ctx, &request.GetLoadBalancerIPAddressesRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	for _, ip := range ipAddresses {
+		addresses = append(addresses, ip.Address)
+	}
+	return addresses, nil
+}
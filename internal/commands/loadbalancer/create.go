@@ -0,0 +1,263 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var maintenanceDOWs = []string{
+	string(upcloud.LoadBalancerMaintenanceDOWMonday),
+	string(upcloud.LoadBalancerMaintenanceDOWTuesday),
+	string(upcloud.LoadBalancerMaintenanceDOWWednesday),
+	string(upcloud.LoadBalancerMaintenanceDOWThursday),
+	string(upcloud.LoadBalancerMaintenanceDOWFriday),
+	string(upcloud.LoadBalancerMaintenanceOWSaturday),
+	string(upcloud.LoadBalancerMaintenanceDOWSunday),
+}
+
+// CreateCommand creates the "load-balancer create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a load balancer",
+			`upctl load-balancer create \
+				--name my-load-balancer \
+				--plan development \
+				--zone fi-hel1 \
+				--network name=public,type=public \
+				--network name=private,type=private,network=my-network`,
+			`upctl load-balancer create \
+				--name my-load-balancer \
+				--plan production-small \
+				--zone de-fra1 \
+				--network name=private,type=private,network=03e5ca07-f36c-4957-a676-e001e40441eb \
+				--label env=prod \
+				--maintenance-dow sunday \
+				--maintenance-time 02:00:00 \
+				--wait`,
+			"upctl load-balancer create --definition load-balancer.yaml",
+			"upctl load-balancer create --definition load-balancer.json --name my-load-balancer --zone fi-hel2",
+		),
+	}
+}
+
+type createParams struct {
+	request.CreateLoadBalancerRequest
+	definition       string
+	labels           []string
+	networks         []string
+	configuredStatus string
+	maintenanceDOW   string
+	wait             config.OptionalBoolean
+}
+
+func (p *createParams) processParams(exec commands.Executor, stdin io.Reader) (request.CreateLoadBalancerRequest, error) {
+	req := request.CreateLoadBalancerRequest{}
+	if p.definition != "" {
+		if err := ReadDefinition(p.definition, stdin, &req); err != nil {
+			return req, err
+		}
+	}
+
+	// Values defined with flags override the values from the definition
+	if p.Name != "" {
+		req.Name = p.Name
+	}
+	if p.Plan != "" {
+		req.Plan = p.Plan
+	}
+	if p.Zone != "" {
+		req.Zone = p.Zone
+	}
+	if p.configuredStatus != "" {
+		req.ConfiguredStatus = upcloud.LoadBalancerConfiguredStatus(p.configuredStatus)
+	}
+	if req.ConfiguredStatus == "" {
+		req.ConfiguredStatus = upcloud.LoadBalancerConfiguredStatusStarted
+	}
+	if p.maintenanceDOW != "" {
+		req.MaintenanceDOW = upcloud.LoadBalancerMaintenanceDOW(p.maintenanceDOW)
+	}
+	if p.MaintenanceTime != "" {
+		req.MaintenanceTime = p.MaintenanceTime
+	}
+
+	if len(p.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(p.labels)
+		if err != nil {
+			return req, err
+		}
+		req.Labels = labelSlice
+	}
+
+	if len(p.networks) > 0 {
+		req.Networks = nil
+		for _, v := range p.networks {
+			network, err := processNetwork(exec, v)
+			if err != nil {
+				return req, err
+			}
+			req.Networks = append(req.Networks, network)
+		}
+	}
+
+	for _, required := range []struct{ flag, value string }{
+		{"name", req.Name},
+		{"plan", req.Plan},
+		{"zone", req.Zone},
+	} {
+		if required.value == "" {
+			return req, fmt.Errorf("%s is required, define it with --%s flag or in the definition", required.flag, required.flag)
+		}
+	}
+
+	// Frontends, backends, and resolvers must be defined as lists, even if they are empty
+	if req.Frontends == nil {
+		req.Frontends = []request.LoadBalancerFrontend{}
+	}
+	if req.Backends == nil {
+		req.Backends = []request.LoadBalancerBackend{}
+	}
+	if req.Resolvers == nil {
+		req.Resolvers = []request.LoadBalancerResolver{}
+	}
+
+	return req, nil
+}
+
+// processNetwork parses a load balancer network from `--network` flag value. The network of a private load balancer network can be defined with name or UUID.
+func processNetwork(exec commands.Executor, in string) (request.LoadBalancerNetwork, error) {
+	var (
+		network    = request.LoadBalancerNetwork{}
+		networkArg string
+		family     string
+		typ        string
+	)
+	args, err := commands.Parse(in)
+	if err != nil {
+		return network, err
+	}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&network.Name, "name", "", "")
+	fs.StringVar(&typ, "type", "", "")
+	fs.StringVar(&family, "family", string(upcloud.LoadBalancerAddressFamilyIPv4), "")
+	fs.StringVar(&networkArg, "network", "", "")
+	if err := fs.Parse(args); err != nil {
+		return network, err
+	}
+
+	if network.Name == "" {
+		return network, fmt.Errorf("network name is required")
+	}
+	network.Type = upcloud.LoadBalancerNetworkType(typ)
+	network.Family = upcloud.LoadBalancerAddressFamily(family)
+
+	switch network.Type {
+	case upcloud.LoadBalancerNetworkTypePublic:
+		if networkArg != "" {
+			return network, fmt.Errorf("network %s: network can only be defined for private networks", network.Name)
+		}
+	case upcloud.LoadBalancerNetworkTypePrivate:
+		if networkArg == "" {
+			return network, fmt.Errorf("network %s: network is required for private networks", network.Name)
+		}
+		network.UUID, err = namedargs.ResolveNetwork(exec, networkArg)
+		if err != nil {
+			return network, err
+		}
+	default:
+		return network, fmt.Errorf("network %s: invalid network type %q, valid types are %s and %s", network.Name, typ, upcloud.LoadBalancerNetworkTypePublic, upcloud.LoadBalancerNetworkTypePrivate)
+	}
+
+	return network, nil
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	params createParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandDeprecationHelp(c, []string{"loadbalancer"})
+
+	fs := &pflag.FlagSet{}
+	c.params = createParams{CreateLoadBalancerRequest: request.CreateLoadBalancerRequest{}}
+
+	fs.StringVar(&c.params.definition, "definition", "", "Path to a JSON or YAML file that defines the load balancer, including its frontends, backends, and resolvers. The keys match the keys of the API request. Use `-` to read the definition from stdin. Values defined with flags override the values in the definition.")
+	fs.StringVar(&c.params.Name, "name", "", "Load balancer name.")
+	fs.StringVar(&c.params.Plan, "plan", "", "Plan to use for the load balancer. Run `upctl load-balancer plans` to list all available plans.")
+	fs.StringVar(&c.params.Zone, "zone", "", namedargs.ZoneDescription("load balancer"))
+	fs.StringArrayVar(
+		&c.params.networks,
+		"network",
+		nil,
+		"Network to attach the load balancer to, multiple can be declared. The network of a private load balancer network can be defined with name or UUID.\n"+
+			"Usage: `--network name=public,type=public`\n\n"+
+			"`--network name=private,type=private,network=my-network,family=IPv4`",
+	)
+	fs.StringArrayVar(&c.params.labels, "label", nil, "Labels to describe the load balancer in `key=value` format, multiple can be declared.")
+	fs.StringVar(&c.params.configuredStatus, "configured-status", "", "Configured status of the load balancer. Valid values are "+namedargs.ValidValuesHelp(configuredStatuses...)+". Defaults to `started`.")
+	fs.StringVar(&c.params.maintenanceDOW, "maintenance-dow", "", "Day of the week for automatic maintenance. Valid values are "+namedargs.ValidValuesHelp(maintenanceDOWs...)+". Set randomly if not provided.")
+	fs.StringVar(&c.params.MaintenanceTime, "maintenance-time", "", "Time of automatic maintenance in UTC in `HH:MM:SS` format. Set randomly if not provided.")
+	config.AddToggleFlag(fs, &c.params.wait, "wait", false, "Wait for load balancer to be in the state matching its configured status before returning.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"name", "network", "label", "maintenance-time"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("configured-status", cobra.FixedCompletions(configuredStatuses, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("maintenance-dow", cobra.FixedCompletions(maintenanceDOWs, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+func (c *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("plan", namedargs.CompletionFunc(completion.LoadBalancerPlan{}, cfg)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (c *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandExecutionDeprecationMessage(c, []string{"loadbalancer"}, "load-balancer")
+
+	req, err := c.params.processParams(exec, c.Cobra().InOrStdin())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating load balancer %s", req.Name)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancer(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if c.params.wait.Value() {
+		waitForOperationalState(res.UUID, operationalStateForStatus(res.ConfiguredStatus), exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
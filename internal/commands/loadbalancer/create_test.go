@@ -0,0 +1,180 @@
+package loadbalancer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDefinition = `
+name: from-definition
+plan: development
+zone: fi-hel1
+networks:
+  - name: public
+    type: public
+    family: IPv4
+frontends:
+  - name: web
+    mode: http
+    port: 80
+    default_backend: web
+backends:
+  - name: web
+    members:
+      - name: member-1
+        ip: 10.0.0.10
+        port: 8080
+        type: static
+        weight: 100
+        max_sessions: 1000
+        enabled: true
+`
+
+func TestCreateCommand(t *testing.T) {
+	network := upcloud.Network{
+		UUID: "03e5ca07-f36c-4957-a676-e001e40441eb",
+		Name: "my-network",
+	}
+	networks := upcloud.Networks{Networks: []upcloud.Network{network}}
+
+	definitionPath := filepath.Join(t.TempDir(), "lb.yaml")
+	require.NoError(t, os.WriteFile(definitionPath, []byte(testDefinition), 0o600))
+
+	baseArgs := []string{"--name", "my-lb", "--plan", "development", "--zone", "fi-hel1"}
+	baseRequest := request.CreateLoadBalancerRequest{
+		Name:             "my-lb",
+		Plan:             "development",
+		Zone:             "fi-hel1",
+		ConfiguredStatus: upcloud.LoadBalancerConfiguredStatusStarted,
+		Frontends:        []request.LoadBalancerFrontend{},
+		Backends:         []request.LoadBalancerBackend{},
+		Resolvers:        []request.LoadBalancerResolver{},
+	}
+
+	networksRequest := baseRequest
+	networksRequest.Networks = []request.LoadBalancerNetwork{
+		{Name: "public", Type: upcloud.LoadBalancerNetworkTypePublic, Family: upcloud.LoadBalancerAddressFamilyIPv4},
+		{Name: "private", Type: upcloud.LoadBalancerNetworkTypePrivate, Family: upcloud.LoadBalancerAddressFamilyIPv4, UUID: network.UUID},
+	}
+
+	settingsRequest := baseRequest
+	settingsRequest.Labels = []upcloud.Label{{Key: "env", Value: "dev"}}
+	settingsRequest.MaintenanceDOW = upcloud.LoadBalancerMaintenanceDOWSunday
+	settingsRequest.MaintenanceTime = "02:00:00"
+
+	stoppedRequest := baseRequest
+	stoppedRequest.ConfiguredStatus = upcloud.LoadBalancerConfiguredStatusStopped
+
+	definitionRequest := request.CreateLoadBalancerRequest{
+		Name:             "override",
+		Plan:             "development",
+		Zone:             "fi-hel1",
+		ConfiguredStatus: upcloud.LoadBalancerConfiguredStatusStarted,
+		Networks: []request.LoadBalancerNetwork{
+			{Name: "public", Type: upcloud.LoadBalancerNetworkTypePublic, Family: upcloud.LoadBalancerAddressFamilyIPv4},
+		},
+		Frontends: []request.LoadBalancerFrontend{
+			{Name: "web", Mode: upcloud.LoadBalancerModeHTTP, Port: 80, DefaultBackend: "web"},
+		},
+		Backends: []request.LoadBalancerBackend{
+			{Name: "web", Members: []request.LoadBalancerBackendMember{
+				{Name: "member-1", IP: "10.0.0.10", Port: 8080, Type: upcloud.LoadBalancerBackendMemberTypeStatic, Weight: 100, MaxSessions: 1000, Enabled: true},
+			}},
+		},
+		Resolvers: []request.LoadBalancerResolver{},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateLoadBalancerRequest
+		errorMsg string
+	}{
+		{
+			name:     "required flags",
+			args:     baseArgs,
+			expected: baseRequest,
+		},
+		{
+			name: "networks",
+			args: append(baseArgs,
+				"--network", "name=public,type=public",
+				"--network", "name=private,type=private,network=my-network",
+			),
+			expected: networksRequest,
+		},
+		{
+			name: "labels and maintenance window",
+			args: append(baseArgs,
+				"--label", "env=dev",
+				"--maintenance-dow", "sunday",
+				"--maintenance-time", "02:00:00",
+			),
+			expected: settingsRequest,
+		},
+		{
+			name:     "configured status",
+			args:     append(baseArgs, "--configured-status", "stopped"),
+			expected: stoppedRequest,
+		},
+		{
+			name:     "definition with overrides",
+			args:     []string{"--definition", definitionPath, "--name", "override"},
+			expected: definitionRequest,
+		},
+		{
+			name:     "missing zone",
+			args:     []string{"--name", "my-lb", "--plan", "development"},
+			errorMsg: "zone is required, define it with --zone flag or in the definition",
+		},
+		{
+			name:     "private network without network",
+			args:     append(baseArgs, "--network", "name=private,type=private"),
+			errorMsg: "network private: network is required for private networks",
+		},
+		{
+			name:     "invalid network type",
+			args:     append(baseArgs, "--network", "name=private"),
+			errorMsg: `network private: invalid network type "", valid types are public and private`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("CreateLoadBalancer", &expected).Return(&upcloud.LoadBalancer{UUID: "lb-uuid"}, nil)
+			mService.On("GetNetworks").Return(&networks, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				mService.AssertNotCalled(t, "CreateLoadBalancer")
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancer", 1)
+			}
+		})
+	}
+}
+
+func TestParseDefinition_UnknownKey(t *testing.T) {
+	req := request.CreateLoadBalancerRequest{}
+	err := ReadDefinition("-", strings.NewReader("name: lb\nplna: development\n"), &req)
+	assert.EqualError(t, err, `cannot parse definition: json: unknown field "plna"`)
+}
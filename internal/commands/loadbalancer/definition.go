@@ -0,0 +1,50 @@
+package loadbalancer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// ReadDefinition reads a JSON or YAML definition from the given path into v. The keys of the definition match the keys of the API request. If path is -, the definition is read from stdin.
+func ReadDefinition(path string, stdin io.Reader, v any) error {
+	var r io.Reader = stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("cannot read definition: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return parseDefinition(r, v)
+}
+
+// parseDefinition parses a JSON or YAML definition into v. YAML is converted to JSON first, so that the JSON field tags of the request types can be used. Unknown keys are considered errors to catch typos early.
+func parseDefinition(r io.Reader, v any) error {
+	var doc any
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("definition is empty")
+		}
+		return fmt.Errorf("cannot parse definition: %w", err)
+	}
+
+	jsonDoc, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("cannot parse definition: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonDoc))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("cannot parse definition: %w", err)
+	}
+	return nil
+}
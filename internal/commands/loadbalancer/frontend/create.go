@@ -0,0 +1,82 @@
+package loadbalancerfrontend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer frontend create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a frontend to a load balancer",
+			"upctl load-balancer frontend create my-load-balancer --name web --mode http --port 80 --default-backend web --network public",
+			"upctl load-balancer frontend create 55199a44-4751-4e27-9394-7c7661910be3 --name db --mode tcp --port 5432 --default-backend db --network private --timeout-client 30",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend   request.LoadBalancerFrontend
+	mode       string
+	networks   []string
+	properties propertiesParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.frontend.Name, "name", "", "Frontend name.")
+	fs.StringVar(&c.mode, "mode", "", "Frontend mode. Valid values are "+namedargs.ValidValuesHelp(modes...)+".")
+	fs.IntVar(&c.frontend.Port, "port", 0, "Port to listen for incoming requests.")
+	fs.StringVar(&c.frontend.DefaultBackend, "default-backend", "", "Name of the backend where requests are forwarded by default.")
+	fs.StringArrayVar(&c.networks, "network", nil, "Name of the load balancer network to listen for incoming requests, multiple can be declared. Defaults to all public networks of the load balancer.")
+	c.properties.addFlags(fs)
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"name", "mode", "port", "default-backend"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	for _, flag := range []string{"name", "port", "default-backend", "network", "timeout-client"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("mode", cobra.FixedCompletions(modes, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *createCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	frontend := c.frontend
+	frontend.Mode = upcloud.LoadBalancerMode(c.mode)
+	frontend.Networks = networks(c.networks)
+	frontend.Properties = c.properties.properties()
+
+	msg := fmt.Sprintf("Creating frontend %s to load balancer %s", frontend.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancerFrontend(exec.Context(), &request.CreateLoadBalancerFrontendRequest{
+		ServiceUUID: uuid,
+		Frontend:    frontend,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,83 @@
+package loadbalancerfrontend
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateLoadBalancerFrontendRequest
+		errorMsg string
+	}{
+		{
+			name:     "missing required flags",
+			args:     []string{lbUUID, "--name", "web"},
+			errorMsg: `required flag(s) "mode", "port", "default-backend" not set`,
+		},
+		{
+			name: "http frontend",
+			args: []string{lbUUID, "--name", "web", "--mode", "http", "--port", "80", "--default-backend", "web", "--network", "public"},
+			expected: request.CreateLoadBalancerFrontendRequest{
+				ServiceUUID: lbUUID,
+				Frontend: request.LoadBalancerFrontend{
+					Name:           "web",
+					Mode:           upcloud.LoadBalancerModeHTTP,
+					Port:           80,
+					DefaultBackend: "web",
+					Networks:       []upcloud.LoadBalancerFrontendNetwork{{Name: "public"}},
+				},
+			},
+		},
+		{
+			name: "properties",
+			args: []string{lbUUID, "--name", "web", "--mode", "http", "--port", "443", "--default-backend", "web", "--timeout-client", "30", "--enable-http2", "--disable-inbound-proxy-protocol"},
+			expected: request.CreateLoadBalancerFrontendRequest{
+				ServiceUUID: lbUUID,
+				Frontend: request.LoadBalancerFrontend{
+					Name:           "web",
+					Mode:           upcloud.LoadBalancerModeHTTP,
+					Port:           443,
+					DefaultBackend: "web",
+					Properties: &upcloud.LoadBalancerFrontendProperties{
+						TimeoutClient:        30,
+						InboundProxyProtocol: upcloud.BoolPtr(false),
+						HTTP2Enabled:         upcloud.BoolPtr(true),
+					},
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("CreateLoadBalancerFrontend", &expected).Return(&upcloud.LoadBalancerFrontend{Name: "web"}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancerFrontend", 1)
+			}
+		})
+	}
+}
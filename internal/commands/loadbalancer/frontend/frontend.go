@@ -0,0 +1,74 @@
+package loadbalancerfrontend
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+var modes = []string{
+	string(upcloud.LoadBalancerModeHTTP),
+	string(upcloud.LoadBalancerModeTCP),
+}
+
+// BaseFrontendCommand creates the base "load-balancer frontend" command
+func BaseFrontendCommand() commands.Command {
+	return &frontendCommand{
+		commands.New("frontend", "Manage load balancer frontends"),
+	}
+}
+
+type frontendCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *frontendCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"frontends"}
+}
+
+// propertiesParams contains the flags for the frontend properties shared by create and modify commands.
+type propertiesParams struct {
+	timeoutClient        int
+	inboundProxyProtocol config.OptionalBoolean
+	http2                config.OptionalBoolean
+}
+
+func (p *propertiesParams) addFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&p.timeoutClient, "timeout-client", 0, "Client request timeout in seconds.")
+	config.AddEnableDisableFlags(fs, &p.inboundProxyProtocol, "inbound-proxy-protocol", "inbound PROXY protocol support")
+	config.AddEnableDisableFlags(fs, &p.http2, "http2", "HTTP/2 support. Only applicable for frontends in http mode")
+}
+
+// properties returns the frontend properties defined with flags or nil, if none of the properties were defined.
+func (p *propertiesParams) properties() *upcloud.LoadBalancerFrontendProperties {
+	if p.timeoutClient == 0 && !p.inboundProxyProtocol.IsSet() && !p.http2.IsSet() {
+		return nil
+	}
+
+	properties := &upcloud.LoadBalancerFrontendProperties{
+		TimeoutClient: p.timeoutClient,
+	}
+	if p.inboundProxyProtocol.IsSet() {
+		properties.InboundProxyProtocol = upcloud.BoolPtr(p.inboundProxyProtocol.Value())
+	}
+	if p.http2.IsSet() {
+		properties.HTTP2Enabled = upcloud.BoolPtr(p.http2.Value())
+	}
+	return properties
+}
+
+// networks converts the network names given with `--network` flag to frontend networks.
+func networks(names []string) []upcloud.LoadBalancerFrontendNetwork {
+	if len(names) == 0 {
+		return nil
+	}
+
+	networks := make([]upcloud.LoadBalancerFrontendNetwork, 0, len(names))
+	for _, name := range names {
+		networks = append(networks, upcloud.LoadBalancerFrontendNetwork{Name: name})
+	}
+	return networks
+}
@@ -0,0 +1,64 @@
+package loadbalancerfrontend
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the "load-balancer frontend list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List frontends of a load balancer",
+			"upctl load-balancer frontend list 55199a44-4751-4e27-9394-7c7661910be3",
+			"upctl load-balancer frontend list my-load-balancer",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	frontends, err := exec.All().GetLoadBalancerFrontends(exec.Context(), &request.GetLoadBalancerFrontendsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, frontend := range frontends {
+		rows = append(rows, output.TableRow{
+			frontend.Name,
+			frontend.Mode,
+			frontend.Port,
+			frontend.DefaultBackend,
+			len(frontend.Rules),
+			len(frontend.TLSConfigs),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: frontends,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "mode", Header: "Mode"},
+				{Key: "port", Header: "Port"},
+				{Key: "default_backend", Header: "Default backend"},
+				{Key: "rules", Header: "Rules"},
+				{Key: "tls_configs", Header: "TLS configs"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No frontends found for this load balancer.",
+		},
+	}, nil
+}
@@ -0,0 +1,83 @@
+package loadbalancerfrontend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer frontend modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer frontend",
+			"upctl load-balancer frontend modify my-load-balancer --name web --port 8080",
+			"upctl load-balancer frontend modify my-load-balancer --name web --new-name www --default-backend www --enable-http2",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name       string
+	frontend   request.ModifyLoadBalancerFrontend
+	mode       string
+	networks   []string
+	properties propertiesParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Name of the frontend to modify.")
+	fs.StringVar(&c.frontend.Name, "new-name", "", "New name for the frontend.")
+	fs.StringVar(&c.mode, "mode", "", "Frontend mode. Valid values are "+namedargs.ValidValuesHelp(modes...)+".")
+	fs.IntVar(&c.frontend.Port, "port", 0, "Port to listen for incoming requests.")
+	fs.StringVar(&c.frontend.DefaultBackend, "default-backend", "", "Name of the backend where requests are forwarded by default.")
+	fs.StringArrayVar(&c.networks, "network", nil, "Name of the load balancer network to listen for incoming requests, multiple can be declared. Replaces the current networks of the frontend.")
+	c.properties.addFlags(fs)
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	for _, flag := range []string{"name", "new-name", "port", "default-backend", "network", "timeout-client"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("mode", cobra.FixedCompletions(modes, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	frontend := c.frontend
+	frontend.Mode = upcloud.LoadBalancerMode(c.mode)
+	frontend.Networks = networks(c.networks)
+	frontend.Properties = c.properties.properties()
+
+	msg := fmt.Sprintf("Modifying frontend %s of load balancer %s", c.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyLoadBalancerFrontend(exec.Context(), &request.ModifyLoadBalancerFrontendRequest{
+		ServiceUUID: uuid,
+		Name:        c.name,
+		Frontend:    frontend,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,75 @@
+package loadbalancerfrontend
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.ModifyLoadBalancerFrontendRequest
+		errorMsg string
+	}{
+		{
+			name:     "no name",
+			args:     []string{lbUUID, "--port", "8080"},
+			errorMsg: `required flag(s) "name" not set`,
+		},
+		{
+			name: "rename and change port",
+			args: []string{lbUUID, "--name", "web", "--new-name", "www", "--port", "8080"},
+			expected: request.ModifyLoadBalancerFrontendRequest{
+				ServiceUUID: lbUUID,
+				Name:        "web",
+				Frontend: request.ModifyLoadBalancerFrontend{
+					Name: "www",
+					Port: 8080,
+				},
+			},
+		},
+		{
+			name: "networks and properties",
+			args: []string{lbUUID, "--name", "web", "--network", "public", "--network", "private", "--disable-http2"},
+			expected: request.ModifyLoadBalancerFrontendRequest{
+				ServiceUUID: lbUUID,
+				Name:        "web",
+				Frontend: request.ModifyLoadBalancerFrontend{
+					Networks:   []upcloud.LoadBalancerFrontendNetwork{{Name: "public"}, {Name: "private"}},
+					Properties: &upcloud.LoadBalancerFrontendProperties{HTTP2Enabled: upcloud.BoolPtr(false)},
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("ModifyLoadBalancerFrontend", &expected).Return(&upcloud.LoadBalancerFrontend{Name: "web"}, nil)
+
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerFrontend", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package loadbalancerfrontendrule
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+var actionTypes = []string{
+	string(upcloud.LoadBalancerActionTypeUseBackend),
+	string(upcloud.LoadBalancerActionTypeTCPReject),
+	string(upcloud.LoadBalancerActionTypeHTTPReturn),
+	string(upcloud.LoadBalancerActionTypeHTTPRedirect),
+	string(upcloud.LoadBalancerActionTypeSetForwardedHeaders),
+	string(upcloud.LoadBalancerActionTypeSetRequestHeader),
+	string(upcloud.LoadBalancerActionTypeSetResponseHeader),
+}
+
+// processAction parses a rule action from `--action` flag value. The available keys depend on the action type, for example: `type=use_backend,backend=web` or `type=http_redirect,scheme=https,status=301`.
+func processAction(in string) (upcloud.LoadBalancerAction, error) {
+	var (
+		action      = upcloud.LoadBalancerAction{}
+		typ         string
+		backend     string
+		status      int
+		contentType string
+		payload     string
+		location    string
+		scheme      string
+		header      string
+		value       string
+	)
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return action, err
+	}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&typ, "type", "", "")
+	fs.StringVar(&backend, "backend", "", "")
+	fs.IntVar(&status, "status", 0, "")
+	fs.StringVar(&contentType, "content-type", "", "")
+	fs.StringVar(&payload, "payload", "", "")
+	fs.StringVar(&location, "location", "", "")
+	fs.StringVar(&scheme, "scheme", "", "")
+	fs.StringVar(&header, "header", "", "")
+	fs.StringVar(&value, "value", "", "")
+	if err := fs.Parse(args); err != nil {
+		return action, fmt.Errorf("action %q: %w", in, err)
+	}
+
+	action.Type = upcloud.LoadBalancerActionType(typ)
+	switch action.Type {
+	case upcloud.LoadBalancerActionTypeUseBackend:
+		action.UseBackend = &upcloud.LoadBalancerActionUseBackend{Backend: backend}
+	case upcloud.LoadBalancerActionTypeTCPReject:
+		action.TCPReject = &upcloud.LoadBalancerActionTCPReject{}
+	case upcloud.LoadBalancerActionTypeHTTPReturn:
+		action.HTTPReturn = &upcloud.LoadBalancerActionHTTPReturn{Status: status, ContentType: contentType, Payload: payload}
+	case upcloud.LoadBalancerActionTypeHTTPRedirect:
+		if location != "" && scheme != "" {
+			return action, fmt.Errorf("action %q: only one of location and scheme can be defined", in)
+		}
+		action.HTTPRedirect = &upcloud.LoadBalancerActionHTTPRedirect{
+			Location: location,
+			Scheme:   upcloud.LoadBalancerActionHTTPRedirectScheme(scheme),
+			Status:   status,
+		}
+	case upcloud.LoadBalancerActionTypeSetForwardedHeaders:
+		action.SetForwardedHeaders = &upcloud.LoadBalancerActionSetForwardedHeaders{}
+	case upcloud.LoadBalancerActionTypeSetRequestHeader:
+		action.SetRequestHeader = &upcloud.LoadBalancerActionSetHeader{Header: header, Value: value}
+	case upcloud.LoadBalancerActionTypeSetResponseHeader:
+		action.SetResponseHeader = &upcloud.LoadBalancerActionSetHeader{Header: header, Value: value}
+	default:
+		return action, fmt.Errorf("action %q: invalid action type %q", in, typ)
+	}
+
+	return action, nil
+}
@@ -0,0 +1,80 @@
+package loadbalancerfrontendrule
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer frontend rule create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a rule to a load balancer frontend",
+			`upctl load-balancer frontend rule create my-load-balancer \
+				--frontend web \
+				--name api \
+				--priority 10 \
+				--matcher type=path,method=starts,value=/api \
+				--action type=use_backend,backend=api`,
+			`upctl load-balancer frontend rule create my-load-balancer \
+				--frontend web \
+				--name redirect-to-https \
+				--priority 100 \
+				--matching-condition or \
+				--matcher type=host,value=example.com \
+				--matcher type=host,value=www.example.com \
+				--action type=http_redirect,scheme=https`,
+			"upctl load-balancer frontend rule create my-load-balancer --frontend web --definition rule.yaml",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	params ruleParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	c.params.addFlags(fs)
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("frontend"))
+	c.params.registerFlagCompletions(c.Cobra())
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *createCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	rule, err := c.params.processParams(c.Cobra().InOrStdin(), c.Cobra().Flags().Changed("priority"))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating rule %s to frontend %s of load balancer %s", rule.Name, c.params.frontend, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancerFrontendRule(exec.Context(), &request.CreateLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  uuid,
+		FrontendName: c.params.frontend,
+		Rule:         rule,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,134 @@
+package loadbalancerfrontendrule
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDefinition = `
+name: api
+priority: 10
+matching_condition: and
+matchers:
+  - type: path
+    match_path:
+      method: starts
+      value: /api
+actions:
+  - type: use_backend
+    action_use_backend:
+      backend: api
+`
+
+func TestCreateCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	definitionPath := filepath.Join(t.TempDir(), "rule.yaml")
+	require.NoError(t, os.WriteFile(definitionPath, []byte(testDefinition), 0o600))
+
+	apiRule := request.LoadBalancerFrontendRule{
+		Name:              "api",
+		Priority:          10,
+		MatchingCondition: upcloud.LoadBalancerMatchingConditionAnd,
+		Matchers: []upcloud.LoadBalancerMatcher{
+			request.NewLoadBalancerPathMatcher(upcloud.LoadBalancerStringMatcherMethodStarts, "/api", nil),
+		},
+		Actions: []upcloud.LoadBalancerAction{
+			request.NewLoadBalancerUseBackendAction("api"),
+		},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.LoadBalancerFrontendRule
+		errorMsg string
+	}{
+		{
+			name:     "no frontend",
+			args:     []string{lbUUID, "--name", "api"},
+			errorMsg: `required flag(s) "frontend" not set`,
+		},
+		{
+			name:     "no name",
+			args:     []string{lbUUID, "--frontend", "web"},
+			errorMsg: "name is required, define it with --name flag or in the definition",
+		},
+		{
+			name: "flags",
+			args: []string{
+				lbUUID,
+				"--frontend", "web",
+				"--name", "api",
+				"--priority", "10",
+				"--matching-condition", "and",
+				"--matcher", "type=path,method=starts,value=/api",
+				"--action", "type=use_backend,backend=api",
+			},
+			expected: apiRule,
+		},
+		{
+			name:     "definition",
+			args:     []string{lbUUID, "--frontend", "web", "--definition", definitionPath},
+			expected: apiRule,
+		},
+		{
+			name: "definition with overrides",
+			args: []string{
+				lbUUID,
+				"--frontend", "web",
+				"--definition", definitionPath,
+				"--priority", "0",
+				"--action", "type=http_return,status=404,content-type=text/plain,payload=Not found",
+			},
+			expected: request.LoadBalancerFrontendRule{
+				Name:              "api",
+				Priority:          0,
+				MatchingCondition: upcloud.LoadBalancerMatchingConditionAnd,
+				Matchers:          apiRule.Matchers,
+				Actions: []upcloud.LoadBalancerAction{
+					request.NewLoadBalancerHTTPReturnAction(404, "text/plain", "Not found"),
+				},
+			},
+		},
+		{
+			name:     "invalid matcher",
+			args:     []string{lbUUID, "--frontend", "web", "--name", "api", "--matcher", "type=path,mehtod=starts"},
+			errorMsg: `matcher "type=path,mehtod=starts": unknown flag: --mehtod`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := request.CreateLoadBalancerFrontendRuleRequest{
+				ServiceUUID:  lbUUID,
+				FrontendName: "web",
+				Rule:         test.expected,
+			}
+			mService.On("CreateLoadBalancerFrontendRule", &expected).Return(&upcloud.LoadBalancerFrontendRule{Name: "api"}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancerFrontendRule", 1)
+			}
+		})
+	}
+}
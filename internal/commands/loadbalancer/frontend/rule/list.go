@@ -0,0 +1,87 @@
+package loadbalancerfrontendrule
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ListCommand creates the "load-balancer frontend rule list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List rules of a load balancer frontend",
+			"upctl load-balancer frontend rule list my-load-balancer --frontend web",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *listCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.frontend, "frontend", "", "Name of the frontend.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("frontend"))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("frontend", cobra.NoFileCompletions))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	rules, err := exec.All().GetLoadBalancerFrontendRules(exec.Context(), &request.GetLoadBalancerFrontendRulesRequest{
+		ServiceUUID:  uuid,
+		FrontendName: c.frontend,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, rule := range rules {
+		var matchers, actions []string
+		for _, matcher := range rule.Matchers {
+			matchers = append(matchers, string(matcher.Type))
+		}
+		for _, action := range rule.Actions {
+			actions = append(actions, string(action.Type))
+		}
+
+		rows = append(rows, output.TableRow{
+			rule.Name,
+			rule.Priority,
+			rule.MatchingCondition,
+			matchers,
+			actions,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: rules,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "priority", Header: "Priority"},
+				{Key: "matching_condition", Header: "Matching condition"},
+				{Key: "matchers", Header: "Matchers", Format: format.StringSliceSingleLineAnd},
+				{Key: "actions", Header: "Actions", Format: format.StringSliceSingleLineAnd},
+			},
+			Rows:         rows,
+			EmptyMessage: "No rules found for this frontend.",
+		},
+	}, nil
+}
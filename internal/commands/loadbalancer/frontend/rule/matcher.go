@@ -0,0 +1,153 @@
+package loadbalancerfrontendrule
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+var matcherTypes = []string{
+	string(upcloud.LoadBalancerMatcherTypeSrcIP),
+	string(upcloud.LoadBalancerMatcherTypeSrcPort),
+	string(upcloud.LoadBalancerMatcherTypeBodySize),
+	string(upcloud.LoadBalancerMatcherTypePath),
+	string(upcloud.LoadBalancerMatcherTypeURL),
+	string(upcloud.LoadBalancerMatcherTypeURLQuery),
+	string(upcloud.LoadBalancerMatcherTypeHost),
+	string(upcloud.LoadBalancerMatcherTypeHTTPMethod),
+	string(upcloud.LoadBalancerMatcherTypeHTTPStatus),
+	string(upcloud.LoadBalancerMatcherTypeCookie),
+	string(upcloud.LoadBalancerMatcherTypeRequestHeader),
+	string(upcloud.LoadBalancerMatcherTypeResponseHeader),
+	string(upcloud.LoadBalancerMatcherTypeURLParam),
+	string(upcloud.LoadBalancerMatcherTypeNumMembersUp),
+}
+
+// processMatcher parses a rule matcher from `--matcher` flag value. The available keys depend on the matcher type, for example: `type=path,method=starts,value=/api` or `type=src_port,method=range,range-start=8000,range-end=8080`.
+func processMatcher(in string) (upcloud.LoadBalancerMatcher, error) {
+	var (
+		matcher    = upcloud.LoadBalancerMatcher{}
+		typ        string
+		method     string
+		name       string
+		value      string
+		backend    string
+		rangeStart int
+		rangeEnd   int
+		ignoreCase string
+		inverse    string
+	)
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return matcher, err
+	}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&typ, "type", "", "")
+	fs.StringVar(&method, "method", "", "")
+	fs.StringVar(&name, "name", "", "")
+	fs.StringVar(&value, "value", "", "")
+	fs.StringVar(&backend, "backend", "", "")
+	fs.IntVar(&rangeStart, "range-start", 0, "")
+	fs.IntVar(&rangeEnd, "range-end", 0, "")
+	fs.StringVar(&ignoreCase, "ignore-case", "", "")
+	fs.StringVar(&inverse, "inverse", "", "")
+	if err := fs.Parse(args); err != nil {
+		return matcher, fmt.Errorf("matcher %q: %w", in, err)
+	}
+
+	ignoreCasePtr, err := parseOptionalBool("ignore-case", ignoreCase)
+	if err != nil {
+		return matcher, fmt.Errorf("matcher %q: %w", in, err)
+	}
+	inversePtr, err := parseOptionalBool("inverse", inverse)
+	if err != nil {
+		return matcher, fmt.Errorf("matcher %q: %w", in, err)
+	}
+
+	integerMatcher := func() (*upcloud.LoadBalancerMatcherInteger, error) {
+		m := &upcloud.LoadBalancerMatcherInteger{
+			Method:     upcloud.LoadBalancerIntegerMatcherMethod(method),
+			RangeStart: rangeStart,
+			RangeEnd:   rangeEnd,
+		}
+		if value != "" {
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("matcher %q: value must be an integer", in)
+			}
+			m.Value = v
+		}
+		return m, nil
+	}
+	stringMatcher := &upcloud.LoadBalancerMatcherString{
+		Method:     upcloud.LoadBalancerStringMatcherMethod(method),
+		Value:      value,
+		IgnoreCase: ignoreCasePtr,
+	}
+	stringWithArgumentMatcher := &upcloud.LoadBalancerMatcherStringWithArgument{
+		Method:     upcloud.LoadBalancerStringMatcherMethod(method),
+		Name:       name,
+		Value:      value,
+		IgnoreCase: ignoreCasePtr,
+	}
+
+	matcher.Type = upcloud.LoadBalancerMatcherType(typ)
+	switch matcher.Type {
+	case upcloud.LoadBalancerMatcherTypeSrcIP:
+		matcher.SrcIP = &upcloud.LoadBalancerMatcherSourceIP{Value: value}
+	case upcloud.LoadBalancerMatcherTypeSrcPort:
+		matcher.SrcPort, err = integerMatcher()
+	case upcloud.LoadBalancerMatcherTypeBodySize:
+		matcher.BodySize, err = integerMatcher()
+	case upcloud.LoadBalancerMatcherTypeHTTPStatus:
+		matcher.HTTPStatus, err = integerMatcher()
+	case upcloud.LoadBalancerMatcherTypePath:
+		matcher.Path = stringMatcher
+	case upcloud.LoadBalancerMatcherTypeURL:
+		matcher.URL = stringMatcher
+	case upcloud.LoadBalancerMatcherTypeURLQuery:
+		matcher.URLQuery = stringMatcher
+	case upcloud.LoadBalancerMatcherTypeHost:
+		matcher.Host = &upcloud.LoadBalancerMatcherHost{Value: value}
+	case upcloud.LoadBalancerMatcherTypeHTTPMethod:
+		matcher.HTTPMethod = &upcloud.LoadBalancerMatcherHTTPMethod{Value: upcloud.LoadBalancerHTTPMatcherMethod(value)}
+	case upcloud.LoadBalancerMatcherTypeCookie:
+		matcher.Cookie = stringWithArgumentMatcher
+	case upcloud.LoadBalancerMatcherTypeRequestHeader:
+		matcher.RequestHeader = stringWithArgumentMatcher
+	case upcloud.LoadBalancerMatcherTypeResponseHeader:
+		matcher.ResponseHeader = stringWithArgumentMatcher
+	case upcloud.LoadBalancerMatcherTypeURLParam:
+		matcher.URLParam = stringWithArgumentMatcher
+	case upcloud.LoadBalancerMatcherTypeNumMembersUp:
+		var m *upcloud.LoadBalancerMatcherInteger
+		if m, err = integerMatcher(); err == nil {
+			matcher.NumMembersUp = &upcloud.LoadBalancerMatcherNumMembersUp{Method: m.Method, Value: m.Value, Backend: backend}
+		}
+	default:
+		return matcher, fmt.Errorf("matcher %q: invalid matcher type %q", in, typ)
+	}
+	if err != nil {
+		return matcher, err
+	}
+
+	matcher.Inverse = inversePtr
+	return matcher, nil
+}
+
+// parseOptionalBool parses a boolean value of a matcher key. Empty value is returned as nil to omit the key from the request.
+func parseOptionalBool(key, value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a boolean", key)
+	}
+	return &b, nil
+}
@@ -0,0 +1,122 @@
+package loadbalancerfrontendrule
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessMatcher(t *testing.T) {
+	inverse := request.NewLoadBalancerInverseMatcher(request.NewLoadBalancerSrcPortRangeMatcher(8000, 8080))
+
+	for _, test := range []struct {
+		in       string
+		expected upcloud.LoadBalancerMatcher
+		errorMsg string
+	}{
+		{
+			in:       "type=src_ip,value=10.0.0.0/24",
+			expected: request.NewLoadBalancerSrcIPMatcher("10.0.0.0/24"),
+		},
+		{
+			in:       "type=src_port,method=range,range-start=8000,range-end=8080,inverse=true",
+			expected: inverse,
+		},
+		{
+			in:       "type=http_status,method=greater_or_equal,value=500",
+			expected: request.NewLoadBalancerHTTPStatusMatcher(upcloud.LoadBalancerIntegerMatcherMethodGreaterOrEqual, 500),
+		},
+		{
+			in:       "type=url_query,method=exact,value=a=b",
+			expected: request.NewLoadBalancerURLQueryMatcher(upcloud.LoadBalancerStringMatcherMethodExact, "a=b", nil),
+		},
+		{
+			in:       "type=request_header,method=exact,name=X-Env,value=dev,ignore-case=true",
+			expected: request.NewLoadBalancerRequestHeaderMatcher(upcloud.LoadBalancerStringMatcherMethodExact, "X-Env", "dev", upcloud.BoolPtr(true)),
+		},
+		{
+			in:       "type=http_method,value=POST",
+			expected: request.NewLoadBalancerHTTPMethodMatcher(upcloud.LoadBalancerHTTPMatcherMethodPost),
+		},
+		{
+			in:       "type=num_members_up,method=less,value=2,backend=web",
+			expected: request.NewLoadBalancerNumMembersUpMatcher(upcloud.LoadBalancerIntegerMatcherMethodLess, 2, "web"),
+		},
+		{
+			in:       "type=body_size,method=less,value=big",
+			errorMsg: `matcher "type=body_size,method=less,value=big": value must be an integer`,
+		},
+		{
+			in:       "type=path,ignore-case=maybe",
+			errorMsg: `matcher "type=path,ignore-case=maybe": ignore-case must be a boolean`,
+		},
+		{
+			in:       "type=paht",
+			errorMsg: `matcher "type=paht": invalid matcher type "paht"`,
+		},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			matcher, err := processMatcher(test.in)
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, matcher)
+		})
+	}
+}
+
+func TestProcessAction(t *testing.T) {
+	for _, test := range []struct {
+		in       string
+		expected upcloud.LoadBalancerAction
+		errorMsg string
+	}{
+		{
+			in:       "type=use_backend,backend=web",
+			expected: request.NewLoadBalancerUseBackendAction("web"),
+		},
+		{
+			in:       "type=tcp_reject",
+			expected: request.NewLoadBalancerTCPRejectAction(),
+		},
+		{
+			in:       "type=http_redirect,scheme=https,status=301",
+			expected: request.NewLoadBalancerHTTPRedirectSchemeActionWithStatus(upcloud.LoadBalancerActionHTTPRedirectSchemeHTTPS, 301),
+		},
+		{
+			in:       "type=http_redirect,location=https://example.com/?a=b",
+			expected: request.NewLoadBalancerHTTPRedirectAction("https://example.com/?a=b"),
+		},
+		{
+			in:       "type=set_request_header,header=X-Env,value=dev",
+			expected: request.NewLoadBalancerSetRequestHeaderAction("X-Env", "dev"),
+		},
+		{
+			in:       "type=set_forwarded_headers",
+			expected: request.NewLoadBalancerSetForwardedHeadersAction(),
+		},
+		{
+			in:       "type=http_redirect,location=https://example.com,scheme=https",
+			errorMsg: `action "type=http_redirect,location=https://example.com,scheme=https": only one of location and scheme can be defined`,
+		},
+		{
+			in:       "type=use_frontend",
+			errorMsg: `action "type=use_frontend": invalid action type "use_frontend"`,
+		},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			action, err := processAction(test.in)
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, action)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package loadbalancerfrontendrule
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer frontend rule modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer frontend rule",
+			"upctl load-balancer frontend rule modify my-load-balancer --frontend web --name api --priority 20",
+			"upctl load-balancer frontend rule modify my-load-balancer --frontend web --name api --new-name api-v1 --matching-condition or",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend          string
+	name              string
+	newName           string
+	priority          int
+	matchingCondition string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.frontend, "frontend", "", "Name of the frontend.")
+	fs.StringVar(&c.name, "name", "", "Name of the rule to modify.")
+	fs.StringVar(&c.newName, "new-name", "", "New name for the rule.")
+	fs.IntVar(&c.priority, "priority", 0, "Rule priority. Rules with higher priority are evaluated first.")
+	fs.StringVar(&c.matchingCondition, "matching-condition", "", "Defines whether all or any of the matchers must match. Valid values are "+namedargs.ValidValuesHelp(matchingConditions...)+".")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"frontend", "name"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	for _, flag := range []string{"frontend", "name", "new-name", "priority"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("matching-condition", cobra.FixedCompletions(matchingConditions, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	rule := request.ModifyLoadBalancerFrontendRule{
+		Name:              c.newName,
+		MatchingCondition: upcloud.LoadBalancerMatchingCondition(c.matchingCondition),
+	}
+	if c.Cobra().Flags().Changed("priority") {
+		rule.Priority = upcloud.IntPtr(c.priority)
+	}
+
+	msg := fmt.Sprintf("Modifying rule %s of frontend %s of load balancer %s", c.name, c.frontend, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyLoadBalancerFrontendRule(exec.Context(), &request.ModifyLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  uuid,
+		FrontendName: c.frontend,
+		Name:         c.name,
+		Rule:         rule,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,75 @@
+package loadbalancerfrontendrule
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ReplaceCommand creates the "load-balancer frontend rule replace" command
+func ReplaceCommand() commands.Command {
+	return &replaceCommand{
+		BaseCommand: commands.New(
+			"replace",
+			"Replace a load balancer frontend rule",
+			`upctl load-balancer frontend rule replace my-load-balancer \
+				--frontend web \
+				--name api \
+				--priority 10 \
+				--matcher type=path,method=starts,value=/api/v2 \
+				--action type=use_backend,backend=api-v2`,
+			"upctl load-balancer frontend rule replace my-load-balancer --frontend web --name api --definition rule.yaml",
+		),
+	}
+}
+
+type replaceCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	params ruleParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *replaceCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	c.params.addFlags(fs)
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"frontend", "name"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	c.params.registerFlagCompletions(c.Cobra())
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *replaceCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	rule, err := c.params.processParams(c.Cobra().InOrStdin(), c.Cobra().Flags().Changed("priority"))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Replacing rule %s of frontend %s of load balancer %s", c.params.name, c.params.frontend, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ReplaceLoadBalancerFrontendRule(exec.Context(), &request.ReplaceLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  uuid,
+		FrontendName: c.params.frontend,
+		Name:         c.params.name,
+		Rule:         rule,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,137 @@
+package loadbalancerfrontendrule
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var matchingConditions = []string{
+	string(upcloud.LoadBalancerMatchingConditionAnd),
+	string(upcloud.LoadBalancerMatchingConditionOr),
+}
+
+// BaseRuleCommand creates the base "load-balancer frontend rule" command
+func BaseRuleCommand() commands.Command {
+	return &ruleCommand{
+		commands.New("rule", "Manage load balancer frontend rules"),
+	}
+}
+
+type ruleCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *ruleCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"rules"}
+}
+
+// ruleParams contains the flags used to define a rule in create and replace commands.
+type ruleParams struct {
+	frontend          string
+	definition        string
+	name              string
+	priority          int
+	matchingCondition string
+	matchers          []string
+	actions           []string
+}
+
+func (p *ruleParams) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.frontend, "frontend", "", "Name of the frontend.")
+	fs.StringVar(&p.definition, "definition", "", "Path to a JSON or YAML file that defines the rule. The keys match the keys of the API request. Use `-` to read the definition from stdin. Values defined with flags override the values in the definition.")
+	fs.StringVar(&p.name, "name", "", "Rule name.")
+	fs.IntVar(&p.priority, "priority", 0, "Rule priority. Rules with higher priority are evaluated first.")
+	fs.StringVar(&p.matchingCondition, "matching-condition", "", "Defines whether all or any of the matchers must match. Valid values are "+namedargs.ValidValuesHelp(matchingConditions...)+".")
+	fs.StringArrayVar(
+		&p.matchers,
+		"matcher",
+		nil,
+		"Matcher of the rule, multiple can be declared. Valid types are "+namedargs.ValidValuesHelp(matcherTypes...)+". Replaces the matchers of the definition.\n"+
+			"Usage: `--matcher type=path,method=starts,value=/api`\n\n"+
+			"`--matcher type=request_header,method=exact,name=X-Env,value=dev,ignore-case=true`\n\n"+
+			"`--matcher type=src_port,method=range,range-start=8000,range-end=8080,inverse=true`",
+	)
+	fs.StringArrayVar(
+		&p.actions,
+		"action",
+		nil,
+		"Action of the rule, multiple can be declared. Valid types are "+namedargs.ValidValuesHelp(actionTypes...)+". Replaces the actions of the definition.\n"+
+			"Usage: `--action type=use_backend,backend=web`\n\n"+
+			"`--action type=http_redirect,scheme=https,status=301`\n\n"+
+			"`--action type=http_return,status=404,content-type=text/plain,payload=Not found`",
+	)
+}
+
+func (p *ruleParams) registerFlagCompletions(cmd *cobra.Command) {
+	for _, flag := range []string{"frontend", "name", "priority", "matcher", "action"} {
+		commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(cmd.RegisterFlagCompletionFunc("matching-condition", cobra.FixedCompletions(matchingConditions, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+// processParams builds the rule from the definition and the flags. Priority is only overridden, if it was explicitly set with flag.
+func (p *ruleParams) processParams(stdin io.Reader, prioritySet bool) (request.LoadBalancerFrontendRule, error) {
+	rule := request.LoadBalancerFrontendRule{}
+	if p.definition != "" {
+		if err := loadbalancer.ReadDefinition(p.definition, stdin, &rule); err != nil {
+			return rule, err
+		}
+	}
+
+	// Values defined with flags override the values from the definition
+	if p.name != "" {
+		rule.Name = p.name
+	}
+	if prioritySet {
+		rule.Priority = p.priority
+	}
+	if p.matchingCondition != "" {
+		rule.MatchingCondition = upcloud.LoadBalancerMatchingCondition(p.matchingCondition)
+	}
+
+	if len(p.matchers) > 0 {
+		rule.Matchers = nil
+		for _, v := range p.matchers {
+			matcher, err := processMatcher(v)
+			if err != nil {
+				return rule, err
+			}
+			rule.Matchers = append(rule.Matchers, matcher)
+		}
+	}
+
+	if len(p.actions) > 0 {
+		rule.Actions = nil
+		for _, v := range p.actions {
+			action, err := processAction(v)
+			if err != nil {
+				return rule, err
+			}
+			rule.Actions = append(rule.Actions, action)
+		}
+	}
+
+	if rule.Name == "" {
+		return rule, fmt.Errorf("name is required, define it with --name flag or in the definition")
+	}
+
+	// Matchers and actions must be defined as lists, even if they are empty
+	if rule.Matchers == nil {
+		rule.Matchers = []upcloud.LoadBalancerMatcher{}
+	}
+	if rule.Actions == nil {
+		rule.Actions = []upcloud.LoadBalancerAction{}
+	}
+
+	return rule, nil
+}
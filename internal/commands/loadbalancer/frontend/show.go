@@ -0,0 +1,136 @@
+package loadbalancerfrontend
+
+import (
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ShowCommand creates the "load-balancer frontend show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show load balancer frontend details",
+			"upctl load-balancer frontend show my-load-balancer --name web",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *showCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Frontend name.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("name", cobra.NoFileCompletions))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *showCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	frontend, err := exec.All().GetLoadBalancerFrontend(exec.Context(), &request.GetLoadBalancerFrontendRequest{ServiceUUID: uuid, Name: c.name})
+	if err != nil {
+		return nil, err
+	}
+
+	var networks []string
+	for _, network := range frontend.Networks {
+		networks = append(networks, network.Name)
+	}
+
+	overviewRows := []output.DetailRow{
+		{Title: "Name:", Value: frontend.Name},
+		{Title: "Mode:", Value: frontend.Mode},
+		{Title: "Port:", Value: frontend.Port},
+		{Title: "Default backend:", Value: frontend.DefaultBackend},
+		{Title: "Networks:", Value: strings.Join(networks, ", ")},
+	}
+	if props := frontend.Properties; props != nil {
+		overviewRows = append(overviewRows, output.DetailRow{Title: "Client timeout:", Value: props.TimeoutClient})
+		if props.InboundProxyProtocol != nil {
+			overviewRows = append(overviewRows, output.DetailRow{Title: "Inbound proxy protocol:", Value: *props.InboundProxyProtocol, Format: format.Boolean})
+		}
+		if props.HTTP2Enabled != nil {
+			overviewRows = append(overviewRows, output.DetailRow{Title: "HTTP/2 enabled:", Value: *props.HTTP2Enabled, Format: format.Boolean})
+		}
+	}
+
+	ruleRows := []output.TableRow{}
+	for _, rule := range frontend.Rules {
+		ruleRows = append(ruleRows, output.TableRow{
+			rule.Name,
+			rule.Priority,
+			rule.MatchingCondition,
+			len(rule.Matchers),
+			len(rule.Actions),
+		})
+	}
+
+	tlsConfigRows := []output.TableRow{}
+	for _, tlsConfig := range frontend.TLSConfigs {
+		tlsConfigRows = append(tlsConfigRows, output.TableRow{
+			tlsConfig.Name,
+			tlsConfig.CertificateBundleUUID,
+		})
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows:  overviewRows,
+					},
+				},
+			},
+		},
+		output.CombinedSection{
+			Title: "Rules:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "priority", Header: "Priority"},
+					{Key: "matching_condition", Header: "Matching condition"},
+					{Key: "matchers", Header: "Matchers"},
+					{Key: "actions", Header: "Actions"},
+				},
+				Rows: ruleRows,
+			},
+		},
+		output.CombinedSection{
+			Title: "TLS configs:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "certificate_bundle_uuid", Header: "Certificate bundle UUID", Colour: ui.DefaultUUUIDColours},
+				},
+				Rows: tlsConfigRows,
+			},
+		},
+	}
+
+	// For JSON and YAML output, passthrough API response
+	return output.MarshaledWithHumanOutput{
+		Value:  frontend,
+		Output: combined,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package loadbalancerfrontendtlsconfig
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// DeleteCommand creates the "load-balancer frontend tls-config delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a TLS config of a load balancer frontend",
+			"upctl load-balancer frontend tls-config delete my-load-balancer --frontend web --name example-com",
+		),
+		params: newTLSConfigParams(),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	params loadbalancer.TLSConfigParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *deleteCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	c.params.AddParentFlag(fs)
+	c.params.AddNameFlag(fs, "TLS config name.")
+	c.AddFlags(fs)
+
+	c.params.RegisterFlags(c.Cobra(), "frontend", "name")
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *deleteCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting TLS config %s of frontend %s of load balancer %s", c.params.Name, c.params.Parent, uuid)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().DeleteLoadBalancerFrontendTLSConfig(exec.Context(), &request.DeleteLoadBalancerFrontendTLSConfigRequest{
+		ServiceUUID:  uuid,
+		FrontendName: c.params.Parent,
+		Name:         c.params.Name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
@@ -0,0 +1,62 @@
+package loadbalancerfrontendtlsconfig
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ListCommand creates the "load-balancer frontend tls-config list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List TLS configs of a load balancer frontend",
+			"upctl load-balancer frontend tls-config list my-load-balancer --frontend web",
+		),
+		params: newTLSConfigParams(),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	params loadbalancer.TLSConfigParams
+}
+
+// InitCommand implements Command.InitCommand
+func (c *listCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	c.params.AddParentFlag(fs)
+	c.AddFlags(fs)
+
+	c.params.RegisterFlags(c.Cobra(), "frontend")
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	tlsConfigs, err := exec.All().GetLoadBalancerFrontendTLSConfigs(exec.Context(), &request.GetLoadBalancerFrontendTLSConfigsRequest{
+		ServiceUUID:  uuid,
+		FrontendName: c.params.Parent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, tlsConfig := range tlsConfigs {
+		rows = append(rows, output.TableRow{
+			tlsConfig.Name,
+			tlsConfig.CertificateBundleUUID,
+		})
+	}
+
+	return c.params.TLSConfigsOutput(tlsConfigs, rows), nil
+}
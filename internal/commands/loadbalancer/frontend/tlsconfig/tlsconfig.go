@@ -0,0 +1,28 @@
+package loadbalancerfrontendtlsconfig
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+)
+
+// BaseTLSConfigCommand creates the base "load-balancer frontend tls-config" command
+func BaseTLSConfigCommand() commands.Command {
+	return &tlsConfigCommand{
+		commands.New("tls-config", "Manage load balancer frontend TLS configs"),
+	}
+}
+
+type tlsConfigCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *tlsConfigCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"tls-configs"}
+}
+
+func newTLSConfigParams() loadbalancer.TLSConfigParams {
+	return loadbalancer.TLSConfigParams{
+		ParentType: "frontend",
+	}
+}
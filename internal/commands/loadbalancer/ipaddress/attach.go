@@ -0,0 +1,73 @@
+package loadbalanceripaddress
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// AttachCommand creates the "load-balancer ip-address attach" command
+func AttachCommand() commands.Command {
+	return &attachCommand{
+		BaseCommand: commands.New(
+			"attach",
+			"Attach a floating IP address to a load balancer",
+			"upctl load-balancer ip-address attach my-load-balancer --address 94.237.0.10 --network public",
+		),
+	}
+}
+
+type attachCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	address string
+	network string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *attachCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.address, "address", "", "Floating IP address to attach.")
+	fs.StringVar(&c.network, "network", "", "Name of the load balancer network to attach the floating IP address to.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"address", "network"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *attachCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("address", namedargs.CompletionFunc(completion.FloatingIPAddress{}, cfg)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("network", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingLoadBalancer{}, cfg, loadbalancer.NetworkNames)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *attachCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Attaching floating IP address %s to network %s of load balancer %s", c.address, c.network, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().AttachLoadBalancerIPAddress(exec.Context(), &request.AttachLoadBalancerIPAddressRequest{
+		ServiceUUID: uuid,
+		Address:     c.address,
+		NetworkName: c.network,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,76 @@
+package loadbalanceripaddress
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.AttachLoadBalancerIPAddressRequest
+		errorMsg string
+	}{
+		{
+			name:     "no network",
+			args:     []string{lbUUID, "--address", "94.237.0.10"},
+			errorMsg: `required flag(s) "network" not set`,
+		},
+		{
+			name: "attach",
+			args: []string{lbUUID, "--address", "94.237.0.10", "--network", "public"},
+			expected: request.AttachLoadBalancerIPAddressRequest{
+				ServiceUUID: lbUUID,
+				Address:     "94.237.0.10",
+				NetworkName: "public",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("AttachLoadBalancerIPAddress", &expected).Return(upcloud.LoadBalancerFloatingIPAddress{Address: expected.Address, NetworkName: expected.NetworkName}, nil)
+
+			c := commands.BuildCommand(AttachCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "AttachLoadBalancerIPAddress", 1)
+			}
+		})
+	}
+}
+
+func TestRemoveCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+	expected := request.RemoveLoadBalancerIPAddressRequest{ServiceUUID: lbUUID, Address: "94.237.0.10"}
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("RemoveLoadBalancerIPAddress", &expected).Return(nil)
+
+	c := commands.BuildCommand(RemoveCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{lbUUID, "--address", "94.237.0.10"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	require.NoError(t, err)
+	mService.AssertNumberOfCalls(t, "RemoveLoadBalancerIPAddress", 1)
+}
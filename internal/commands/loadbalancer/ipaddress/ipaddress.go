@@ -0,0 +1,21 @@
+package loadbalanceripaddress
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+)
+
+// BaseIPAddressCommand creates the base "load-balancer ip-address" command
+func BaseIPAddressCommand() commands.Command {
+	return &ipAddressCommand{
+		commands.New("ip-address", "Manage floating IP addresses of load balancers"),
+	}
+}
+
+type ipAddressCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *ipAddressCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"ip-addresses", "ip"}
+}
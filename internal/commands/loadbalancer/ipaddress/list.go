@@ -0,0 +1,56 @@
+package loadbalanceripaddress
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the "load-balancer ip-address list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List floating IP addresses attached to a load balancer",
+			"upctl load-balancer ip-address list my-load-balancer",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	ipAddresses, err := exec.All().GetLoadBalancerIPAddresses(exec.Context(), &request.GetLoadBalancerIPAddressesRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, ip := range ipAddresses {
+		rows = append(rows, output.TableRow{
+			ip.Address,
+			ip.NetworkName,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: ipAddresses,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "address", Header: "Address", Colour: ui.DefaultAddressColours},
+				{Key: "network_name", Header: "Network"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No floating IP addresses attached to this load balancer.",
+		},
+	}, nil
+}
@@ -0,0 +1,67 @@
+package loadbalanceripaddress
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// RemoveCommand creates the "load-balancer ip-address remove" command
+func RemoveCommand() commands.Command {
+	return &removeCommand{
+		BaseCommand: commands.New(
+			"remove",
+			"Remove a floating IP address from a load balancer",
+			"upctl load-balancer ip-address remove my-load-balancer --address 94.237.0.10",
+		),
+	}
+}
+
+type removeCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	address string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *removeCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.address, "address", "", "Floating IP address to remove.")
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("address"))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *removeCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("address", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingLoadBalancer{}, cfg, loadbalancer.IPAddresses)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *removeCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Removing floating IP address %s from load balancer %s", c.address, uuid)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().RemoveLoadBalancerIPAddress(exec.Context(), &request.RemoveLoadBalancerIPAddressRequest{
+		ServiceUUID: uuid,
+		Address:     c.address,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
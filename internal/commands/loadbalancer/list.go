@@ -3,6 +3,7 @@ package loadbalancer
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/paging"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
@@ -54,6 +55,7 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			lb.Plan,
 			lb.Zone,
 			lb.OperationalState,
+			labels.Cell(lb.Labels),
 		})
 	}
 
@@ -66,6 +68,7 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "plan", Header: "Plan"},
 				{Key: "zone", Header: "Zone"},
 				{Key: "operational_state", Header: "State", Format: format.LoadBalancerState},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
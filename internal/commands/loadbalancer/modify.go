@@ -0,0 +1,120 @@
+package loadbalancer
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var configuredStatuses = []string{
+	string(upcloud.LoadBalancerConfiguredStatusStarted),
+	string(upcloud.LoadBalancerConfiguredStatusStopped),
+}
+
+// ModifyCommand creates the "load-balancer modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer",
+			"upctl load-balancer modify my-load-balancer --plan production-small",
+			"upctl load-balancer modify 55199a44-4751-4e27-9394-7c7661910be3 --name my-renamed-load-balancer --label env=dev",
+			"upctl load-balancer modify my-load-balancer --maintenance-dow monday --maintenance-time 03:00:00",
+			"upctl load-balancer modify my-load-balancer --configured-status stopped",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	params           request.ModifyLoadBalancerRequest
+	configuredStatus string
+	maintenanceDOW   string
+	labels           []string
+	clearLabels      config.OptionalBoolean
+	wait             config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandDeprecationHelp(c, []string{"loadbalancer"})
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.params.Name, "name", "", "New name for the load balancer.")
+	fs.StringVar(&c.params.Plan, "plan", "", "New plan for the load balancer. Run `upctl load-balancer plans` to list all available plans.")
+	fs.StringVar(&c.configuredStatus, "configured-status", "", "Configured status of the load balancer. Valid values are "+namedargs.ValidValuesHelp(configuredStatuses...)+".")
+	fs.StringVar(&c.maintenanceDOW, "maintenance-dow", "", "Day of the week for automatic maintenance. Valid values are "+namedargs.ValidValuesHelp(maintenanceDOWs...)+".")
+	fs.StringVar(&c.params.MaintenanceTime, "maintenance-time", "", "Time of automatic maintenance in UTC in `HH:MM:SS` format.")
+	fs.StringArrayVar(&c.labels, "label", nil, "Labels to describe the load balancer in `key=value` format, multiple can be declared.")
+	config.AddToggleFlag(fs, &c.clearLabels, "clear-labels", false, "Clear all labels from the load balancer.")
+	config.AddToggleFlag(fs, &c.wait, "wait", false, "Wait for load balancer to be in the state matching its configured status before returning.")
+	c.AddFlags(fs)
+
+	c.Cobra().MarkFlagsMutuallyExclusive("label", "clear-labels")
+	for _, flag := range []string{"name", "label", "maintenance-time"} {
+		commands.Must(c.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("configured-status", cobra.FixedCompletions(configuredStatuses, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("maintenance-dow", cobra.FixedCompletions(maintenanceDOWs, cobra.ShellCompDirectiveNoFileComp)))
+}
+
+func (c *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("plan", namedargs.CompletionFunc(completion.LoadBalancerPlan{}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandExecutionDeprecationMessage(c, []string{"loadbalancer"}, "load-balancer")
+
+	req := c.params
+	req.UUID = uuid
+	req.ConfiguredStatus = c.configuredStatus
+	req.MaintenanceDOW = upcloud.LoadBalancerMaintenanceDOW(c.maintenanceDOW)
+
+	if c.clearLabels.Value() {
+		req.Labels = &[]upcloud.Label{}
+	}
+
+	if len(c.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(c.labels)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Labels = &labelSlice
+	}
+
+	msg := fmt.Sprintf("Modifying load balancer %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyLoadBalancer(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if c.wait.Value() {
+		waitForOperationalState(res.UUID, operationalStateForStatus(res.ConfiguredStatus), exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
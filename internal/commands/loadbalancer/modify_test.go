@@ -0,0 +1,128 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.ModifyLoadBalancerRequest
+		errorMsg string
+	}{
+		{
+			name:     "no args",
+			args:     []string{lbUUID},
+			expected: request.ModifyLoadBalancerRequest{UUID: lbUUID},
+		},
+		{
+			name: "name, plan, and configured status",
+			args: []string{lbUUID, "--name", "renamed", "--plan", "production-small", "--configured-status", "stopped"},
+			expected: request.ModifyLoadBalancerRequest{
+				UUID:             lbUUID,
+				Name:             "renamed",
+				Plan:             "production-small",
+				ConfiguredStatus: "stopped",
+			},
+		},
+		{
+			name: "maintenance window",
+			args: []string{lbUUID, "--maintenance-dow", "monday", "--maintenance-time", "03:00:00"},
+			expected: request.ModifyLoadBalancerRequest{
+				UUID:            lbUUID,
+				MaintenanceDOW:  upcloud.LoadBalancerMaintenanceDOWMonday,
+				MaintenanceTime: "03:00:00",
+			},
+		},
+		{
+			name: "labels",
+			args: []string{lbUUID, "--label", "env=dev"},
+			expected: request.ModifyLoadBalancerRequest{
+				UUID:   lbUUID,
+				Labels: &[]upcloud.Label{{Key: "env", Value: "dev"}},
+			},
+		},
+		{
+			name: "clear-labels",
+			args: []string{lbUUID, "--clear-labels"},
+			expected: request.ModifyLoadBalancerRequest{
+				UUID:   lbUUID,
+				Labels: &[]upcloud.Label{},
+			},
+		},
+		{
+			name:     "labels and clear-labels",
+			args:     []string{lbUUID, "--label", "env=dev", "--clear-labels"},
+			errorMsg: "if any flags in the group [label clear-labels] are set none of the others can be; [clear-labels label] were all set",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("ModifyLoadBalancer", &expected).Return(&upcloud.LoadBalancer{UUID: lbUUID}, nil)
+
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancer", 1)
+			}
+		})
+	}
+}
+
+func TestModifyCommand_Wait(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name   string
+		status upcloud.LoadBalancerConfiguredStatus
+		state  upcloud.LoadBalancerOperationalState
+	}{
+		{
+			name:   "started",
+			status: upcloud.LoadBalancerConfiguredStatusStarted,
+			state:  upcloud.LoadBalancerOperationalStateRunning,
+		},
+		{
+			name:   "stopped",
+			status: upcloud.LoadBalancerConfiguredStatusStopped,
+			state:  loadBalancerOperationalStateStopped,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			lb := &upcloud.LoadBalancer{UUID: lbUUID, ConfiguredStatus: test.status}
+			mService.On("ModifyLoadBalancer", &request.ModifyLoadBalancerRequest{UUID: lbUUID, ConfiguredStatus: string(test.status)}).Return(lb, nil)
+			mService.On("WaitForLoadBalancerOperationalState", &request.WaitForLoadBalancerOperationalStateRequest{UUID: lbUUID, DesiredState: test.state}).Return(lb, nil)
+
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs([]string{lbUUID, "--configured-status", string(test.status), "--wait"})
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			require.NoError(t, err)
+			mService.AssertExpectations(t)
+		})
+	}
+}
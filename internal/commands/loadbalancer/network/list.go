@@ -0,0 +1,70 @@
+package loadbalancernetwork
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the "load-balancer network list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List networks of a load balancer",
+			"upctl load-balancer network list my-load-balancer",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	lb, err := exec.All().GetLoadBalancer(exec.Context(), &request.GetLoadBalancerRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, network := range lb.Networks {
+		var addresses []string
+		for _, ip := range network.IPAddresses {
+			addresses = append(addresses, ip.Address)
+		}
+
+		rows = append(rows, output.TableRow{
+			network.Name,
+			network.Type,
+			network.Family,
+			network.UUID,
+			network.DNSName,
+			addresses,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: lb.Networks,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "type", Header: "Type"},
+				{Key: "family", Header: "Family"},
+				{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				{Key: "dns_name", Header: "DNS name"},
+				{Key: "ip_addresses", Header: "IP addresses", Colour: ui.DefaultAddressColours, Format: format.StringSliceSingleLineAnd},
+			},
+			Rows:         rows,
+			EmptyMessage: "No networks found for this load balancer.",
+		},
+	}, nil
+}
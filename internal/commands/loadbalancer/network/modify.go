@@ -0,0 +1,74 @@
+package loadbalancernetwork
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer network modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer network",
+			"upctl load-balancer network modify my-load-balancer --name public --new-name public-blue",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name    string
+	newName string
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Name of the network to modify.")
+	fs.StringVar(&c.newName, "new-name", "", "New name for the network.")
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"name", "new-name"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("new-name", cobra.NoFileCompletions))
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (c *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("name", namedargs.ResolvedArgumentCompletionFunc(&resolver.CachingLoadBalancer{}, cfg, loadbalancer.NetworkNames)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Renaming network %s of load balancer %s to %s", c.name, uuid, c.newName)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyLoadBalancerNetwork(exec.Context(), &request.ModifyLoadBalancerNetworkRequest{
+		ServiceUUID: uuid,
+		Name:        c.name,
+		Network:     request.ModifyLoadBalancerNetwork{Name: c.newName},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,60 @@
+package loadbalancernetwork
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.ModifyLoadBalancerNetworkRequest
+		errorMsg string
+	}{
+		{
+			name:     "no new name",
+			args:     []string{lbUUID, "--name", "public"},
+			errorMsg: `required flag(s) "new-name" not set`,
+		},
+		{
+			name: "rename",
+			args: []string{lbUUID, "--name", "public", "--new-name", "public-blue"},
+			expected: request.ModifyLoadBalancerNetworkRequest{
+				ServiceUUID: lbUUID,
+				Name:        "public",
+				Network:     request.ModifyLoadBalancerNetwork{Name: "public-blue"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("ModifyLoadBalancerNetwork", &expected).Return(&upcloud.LoadBalancerNetwork{Name: "public-blue"}, nil)
+
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerNetwork", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package loadbalancernetwork
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+)
+
+// BaseNetworkCommand creates the base "load-balancer network" command
+func BaseNetworkCommand() commands.Command {
+	return &networkCommand{
+		commands.New("network", "Manage load balancer networks"),
+	}
+}
+
+type networkCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *networkCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"networks"}
+}
@@ -0,0 +1,64 @@
+package loadbalancerresolver
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer resolver create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a DNS resolver for a load balancer",
+			"upctl load-balancer resolver create my-load-balancer --name dns --nameserver 10.0.0.2 --nameserver 10.0.0.3:53",
+			"upctl load-balancer resolver create my-load-balancer --name dns --nameserver 10.0.0.2 --retries 3 --timeout 10 --cache-valid 60",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	resolver request.LoadBalancerResolver
+}
+
+// InitCommand implements Command.InitCommand
+func (c *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.resolver.Name, "name", "", "Resolver name.")
+	addResolverFlags(fs, &c.resolver, defaultResolver)
+	c.AddFlags(fs)
+
+	for _, flag := range []string{"name", "nameserver"} {
+		commands.Must(c.Cobra().MarkFlagRequired(flag))
+	}
+	registerResolverFlagCompletions(c.Cobra())
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *createCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating resolver %s for load balancer %s", c.resolver.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancerResolver(exec.Context(), &request.CreateLoadBalancerResolverRequest{
+		ServiceUUID: uuid,
+		Resolver:    c.resolver,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,83 @@
+package loadbalancerresolver
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateLoadBalancerResolverRequest
+		errorMsg string
+	}{
+		{
+			name:     "no nameservers",
+			args:     []string{lbUUID, "--name", "dns"},
+			errorMsg: `required flag(s) "nameserver" not set`,
+		},
+		{
+			name: "defaults",
+			args: []string{lbUUID, "--name", "dns", "--nameserver", "10.0.0.2", "--nameserver", "10.0.0.3:53"},
+			expected: request.CreateLoadBalancerResolverRequest{
+				ServiceUUID: lbUUID,
+				Resolver: request.LoadBalancerResolver{
+					Name:         "dns",
+					Nameservers:  []string{"10.0.0.2", "10.0.0.3:53"},
+					Retries:      5,
+					Timeout:      30,
+					TimeoutRetry: 10,
+					CacheValid:   180,
+					CacheInvalid: 10,
+				},
+			},
+		},
+		{
+			name: "custom values",
+			args: []string{lbUUID, "--name", "dns", "--nameserver", "10.0.0.2", "--retries", "3", "--timeout", "10", "--timeout-retry", "5", "--cache-valid", "60", "--cache-invalid", "5"},
+			expected: request.CreateLoadBalancerResolverRequest{
+				ServiceUUID: lbUUID,
+				Resolver: request.LoadBalancerResolver{
+					Name:         "dns",
+					Nameservers:  []string{"10.0.0.2"},
+					Retries:      3,
+					Timeout:      10,
+					TimeoutRetry: 5,
+					CacheValid:   60,
+					CacheInvalid: 5,
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("CreateLoadBalancerResolver", &expected).Return(&upcloud.LoadBalancerResolver{Name: "dns"}, nil)
+
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancerResolver", 1)
+			}
+		})
+	}
+}
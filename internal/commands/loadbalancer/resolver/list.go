@@ -0,0 +1,66 @@
+package loadbalancerresolver
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the "load-balancer resolver list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List DNS resolvers of a load balancer",
+			"upctl load-balancer resolver list my-load-balancer",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *listCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	resolvers, err := exec.All().GetLoadBalancerResolvers(exec.Context(), &request.GetLoadBalancerResolversRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, r := range resolvers {
+		rows = append(rows, output.TableRow{
+			r.Name,
+			r.Nameservers,
+			r.Retries,
+			r.Timeout,
+			r.TimeoutRetry,
+			r.CacheValid,
+			r.CacheInvalid,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: resolvers,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "nameservers", Header: "Nameservers", Format: format.StringSliceSingleLineAnd},
+				{Key: "retries", Header: "Retries"},
+				{Key: "timeout", Header: "Timeout"},
+				{Key: "timeout_retry", Header: "Retry timeout"},
+				{Key: "cache_valid", Header: "Cache valid"},
+				{Key: "cache_invalid", Header: "Cache invalid"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No resolvers found for this load balancer.",
+		},
+	}, nil
+}
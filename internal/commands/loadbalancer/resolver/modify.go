@@ -0,0 +1,68 @@
+package loadbalancerresolver
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer resolver modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a DNS resolver of a load balancer",
+			"upctl load-balancer resolver modify my-load-balancer --name dns --nameserver 10.0.0.4",
+			"upctl load-balancer resolver modify my-load-balancer --name dns --new-name internal-dns --cache-valid 300",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name     string
+	resolver request.LoadBalancerResolver
+}
+
+// InitCommand implements Command.InitCommand
+func (c *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&c.name, "name", "", "Name of the resolver to modify.")
+	fs.StringVar(&c.resolver.Name, "new-name", "", "New name for the resolver.")
+	addResolverFlags(fs, &c.resolver, request.LoadBalancerResolver{})
+	fs.Lookup("nameserver").Usage += " Replaces the current nameservers."
+	c.AddFlags(fs)
+
+	commands.Must(c.Cobra().MarkFlagRequired("name"))
+	commands.Must(c.Cobra().RegisterFlagCompletionFunc("new-name", cobra.NoFileCompletions))
+	registerResolverFlagCompletions(c.Cobra())
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (c *modifyCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying resolver %s of load balancer %s", c.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyLoadBalancerResolver(exec.Context(), &request.ModifyLoadBalancerResolverRequest{
+		ServiceUUID: uuid,
+		Name:        c.name,
+		Resolver:    c.resolver,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
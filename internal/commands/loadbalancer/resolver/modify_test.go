@@ -0,0 +1,37 @@
+package loadbalancerresolver
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyCommand(t *testing.T) {
+	lbUUID := "55199a44-4751-4e27-9394-7c7661910be3"
+	expected := request.ModifyLoadBalancerResolverRequest{
+		ServiceUUID: lbUUID,
+		Name:        "dns",
+		Resolver: request.LoadBalancerResolver{
+			Name:       "internal-dns",
+			CacheValid: 300,
+		},
+	}
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("ModifyLoadBalancerResolver", &expected).Return(&upcloud.LoadBalancerResolver{Name: "internal-dns"}, nil)
+
+	c := commands.BuildCommand(ModifyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{lbUUID, "--name", "dns", "--new-name", "internal-dns", "--cache-valid", "300"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	require.NoError(t, err)
+	mService.AssertNumberOfCalls(t, "ModifyLoadBalancerResolver", 1)
+}
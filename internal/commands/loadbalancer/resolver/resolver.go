@@ -0,0 +1,50 @@
+package loadbalancerresolver
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// defaultResolver contains the default values used when creating a resolver
+var defaultResolver = request.LoadBalancerResolver{
+	Retries:      5,
+	Timeout:      30,
+	TimeoutRetry: 10,
+	CacheValid:   180,
+	CacheInvalid: 10,
+}
+
+// BaseResolverCommand creates the base "load-balancer resolver" command
+func BaseResolverCommand() commands.Command {
+	return &resolverCommand{
+		commands.New("resolver", "Manage load balancer DNS resolvers"),
+	}
+}
+
+type resolverCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (c *resolverCommand) InitCommand() {
+	c.Cobra().Aliases = []string{"resolvers"}
+}
+
+// addResolverFlags adds the flags for the resolver properties. Default values are taken from def.
+func addResolverFlags(fs *pflag.FlagSet, dst *request.LoadBalancerResolver, def request.LoadBalancerResolver) {
+	fs.StringArrayVar(&dst.Nameservers, "nameserver", def.Nameservers, "Nameserver address, multiple can be declared. Port can be defined with `address:port` syntax.")
+	fs.IntVar(&dst.Retries, "retries", def.Retries, "Number of retries on failure.")
+	fs.IntVar(&dst.Timeout, "timeout", def.Timeout, "Timeout for the query in seconds.")
+	fs.IntVar(&dst.TimeoutRetry, "timeout-retry", def.TimeoutRetry, "Timeout for the query retries in seconds.")
+	fs.IntVar(&dst.CacheValid, "cache-valid", def.CacheValid, "Time in seconds to cache valid results.")
+	fs.IntVar(&dst.CacheInvalid, "cache-invalid", def.CacheInvalid, "Time in seconds to cache invalid results.")
+}
+
+func registerResolverFlagCompletions(cmd *cobra.Command) {
+	for _, flag := range []string{"name", "nameserver", "retries", "timeout", "timeout-retry", "cache-valid", "cache-invalid"} {
+		commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
@@ -72,6 +72,18 @@ func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 		})
 	}
 
+	tlsConfigRows := []output.TableRow{}
+	for _, backEnd := range lb.Backends {
+		for _, tlsConfig := range backEnd.TLSConfigs {
+			tlsConfigRows = append(tlsConfigRows, output.TableRow{
+				"backend",
+				backEnd.Name,
+				tlsConfig.Name,
+				tlsConfig.CertificateBundleUUID,
+			})
+		}
+	}
+
 	frontEndRows := []output.TableRow{}
 	for _, frontEnd := range lb.Frontends {
 		frontEndRows = append(frontEndRows, output.TableRow{
@@ -82,6 +94,15 @@ func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 			frontEnd.DefaultBackend,
 			len(frontEnd.Rules),
 		})
+
+		for _, tlsConfig := range frontEnd.TLSConfigs {
+			tlsConfigRows = append(tlsConfigRows, output.TableRow{
+				"frontend",
+				frontEnd.Name,
+				tlsConfig.Name,
+				tlsConfig.CertificateBundleUUID,
+			})
+		}
 	}
 
 	resolverRows := []output.TableRow{}
@@ -94,6 +115,11 @@ func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 		resolverRows = append(resolverRows, output.TableRow{
 			resolver.Name,
 			strings.Join(nameservers, ", "),
+			resolver.Retries,
+			resolver.Timeout,
+			resolver.TimeoutRetry,
+			resolver.CacheValid,
+			resolver.CacheInvalid,
 		})
 	}
 
@@ -150,10 +176,27 @@ func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 				Columns: []output.TableColumn{
 					{Key: "name", Header: "Name"},
 					{Key: "nameservers", Header: "Nameservers"},
+					{Key: "retries", Header: "Retries"},
+					{Key: "timeout", Header: "Timeout"},
+					{Key: "timeout_retry", Header: "Retry timeout"},
+					{Key: "cache_valid", Header: "Cache valid"},
+					{Key: "cache_invalid", Header: "Cache invalid"},
 				},
 				Rows: resolverRows,
 			},
 		},
+		output.CombinedSection{
+			Title: "TLS configs:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "type", Header: "Type"},
+					{Key: "parent", Header: "Frontend / Backend"},
+					{Key: "name", Header: "Name"},
+					{Key: "certificate_bundle_uuid", Header: "Certificate bundle UUID", Colour: ui.DefaultUUUIDColours},
+				},
+				Rows: tlsConfigRows,
+			},
+		},
 	}
 
 	// For JSON and YAML output, passthrough API response
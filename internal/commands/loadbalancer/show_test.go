@@ -0,0 +1,58 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowCommand_TLSConfigsAndResolvers(t *testing.T) {
+	text.DisableColors()
+
+	lb := upcloud.LoadBalancer{
+		UUID:             "55199a44-4751-4e27-9394-7c7661910be3",
+		Name:             "my-load-balancer",
+		OperationalState: upcloud.LoadBalancerOperationalStateRunning,
+		Frontends: []upcloud.LoadBalancerFrontend{{
+			Name:       "web",
+			TLSConfigs: []upcloud.LoadBalancerFrontendTLSConfig{{Name: "example-com", CertificateBundleUUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2"}},
+		}},
+		Backends: []upcloud.LoadBalancerBackend{{
+			Name:       "app",
+			TLSConfigs: []upcloud.LoadBalancerBackendTLSConfig{{Name: "internal-ca", CertificateBundleUUID: "0a2a4b1c-7c5f-4b43-9b56-2d9f1b0e2d1e"}},
+		}},
+		Resolvers: []upcloud.LoadBalancerResolver{{
+			Name:         "dns",
+			Nameservers:  []string{"10.0.0.2"},
+			Retries:      5,
+			Timeout:      30,
+			TimeoutRetry: 10,
+			CacheValid:   180,
+			CacheInvalid: 10,
+		}},
+	}
+
+	conf := config.New()
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancer", &request.GetLoadBalancerRequest{UUID: lb.UUID}).Return(&lb, nil)
+	mService.On("GetNetworkDetails", mock.Anything).Return(&upcloud.Network{Name: "lb-network"}, nil)
+
+	c := commands.BuildCommand(ShowCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{lb.UUID})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Regexp(t, `frontend\s+web\s+example-com\s+0aded5c1-c7a3-498a-b9c8-a871611c47a2`, out)
+	assert.Regexp(t, `backend\s+app\s+internal-ca\s+0a2a4b1c-7c5f-4b43-9b56-2d9f1b0e2d1e`, out)
+	assert.Regexp(t, `dns\s+10.0.0.2\s+5\s+30\s+10\s+180\s+10`, out)
+}
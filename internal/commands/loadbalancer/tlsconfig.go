@@ -0,0 +1,90 @@
+package loadbalancer
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// TLSConfigParams contains the flags shared by the frontend and backend TLS config commands. Parent is the name of the frontend or backend, depending on ParentType.
+type TLSConfigParams struct {
+	ParentType            string
+	CertificateBundleHelp string
+
+	Parent            string
+	Name              string
+	NewName           string
+	CertificateBundle string
+}
+
+// AddParentFlag adds the flag for the name of the frontend or backend that the TLS config belongs to.
+func (p *TLSConfigParams) AddParentFlag(fs *pflag.FlagSet) {
+	fs.StringVar(&p.Parent, p.ParentType, "", fmt.Sprintf("Name of the %s.", p.ParentType))
+}
+
+// AddNameFlag adds the flag for the name of the TLS config.
+func (p *TLSConfigParams) AddNameFlag(fs *pflag.FlagSet, usage string) {
+	fs.StringVar(&p.Name, "name", "", usage)
+}
+
+// AddNewNameFlag adds the flag for renaming the TLS config.
+func (p *TLSConfigParams) AddNewNameFlag(fs *pflag.FlagSet) {
+	fs.StringVar(&p.NewName, "new-name", "", "New name for the TLS config.")
+}
+
+// AddCertificateBundleFlag adds the flag for the certificate bundle of the TLS config.
+func (p *TLSConfigParams) AddCertificateBundleFlag(fs *pflag.FlagSet) {
+	usage := "Certificate bundle to use. The certificate bundle can be defined with name or UUID."
+	if p.CertificateBundleHelp != "" {
+		usage += " " + p.CertificateBundleHelp
+	}
+	fs.StringVar(&p.CertificateBundle, "certificate-bundle", "", usage)
+}
+
+// RegisterFlags marks the given flags required and disables file completions for the name flags.
+func (p *TLSConfigParams) RegisterFlags(cmd *cobra.Command, required ...string) {
+	for _, flag := range required {
+		commands.Must(cmd.MarkFlagRequired(flag))
+	}
+	for _, flag := range []string{p.ParentType, "name", "new-name"} {
+		if cmd.Flags().Lookup(flag) != nil {
+			commands.Must(cmd.RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+		}
+	}
+}
+
+// RegisterCertificateBundleCompletion registers completion for the certificate bundle flag.
+func (p *TLSConfigParams) RegisterCertificateBundleCompletion(cmd *cobra.Command, cfg *config.Config) {
+	commands.Must(cmd.RegisterFlagCompletionFunc("certificate-bundle", namedargs.CompletionFunc(completion.CertificateBundle{}, cfg)))
+}
+
+// ResolveCertificateBundle resolves the UUID of the certificate bundle given with the certificate bundle flag. Returns an empty string, if the flag was not set.
+func (p *TLSConfigParams) ResolveCertificateBundle(exec commands.Executor) (string, error) {
+	if p.CertificateBundle == "" {
+		return "", nil
+	}
+	return namedargs.ResolveCertificateBundle(exec, p.CertificateBundle)
+}
+
+// TLSConfigsOutput returns the output of the TLS config list commands. Each row should contain the name and certificate bundle UUID of a TLS config.
+func (p *TLSConfigParams) TLSConfigsOutput(tlsConfigs any, rows []output.TableRow) output.Output {
+	return output.MarshaledWithHumanOutput{
+		Value: tlsConfigs,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "certificate_bundle_uuid", Header: "Certificate bundle UUID", Colour: ui.DefaultUUUIDColours},
+			},
+			Rows:         rows,
+			EmptyMessage: fmt.Sprintf("No TLS configs found for this %s.", p.ParentType),
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package loadbalancer
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// loadBalancerOperationalStateStopped is the operational state of a load balancer with stopped configured status. The SDK does not define a constant for it.
+const loadBalancerOperationalStateStopped upcloud.LoadBalancerOperationalState = "stopped"
+
+// operationalStateForStatus returns the operational state that a load balancer reaches with the given configured status.
+func operationalStateForStatus(status upcloud.LoadBalancerConfiguredStatus) upcloud.LoadBalancerOperationalState {
+	if status == upcloud.LoadBalancerConfiguredStatusStopped {
+		return loadBalancerOperationalStateStopped
+	}
+	return upcloud.LoadBalancerOperationalStateRunning
+}
+
+// waitForOperationalState waits for load balancer to reach given operational state and updates progress message with key matching given msg. Finally, progress message is updated back to given msg and either done state or timeout warning.
+func waitForOperationalState(uuid string, state upcloud.LoadBalancerOperationalState, exec commands.Executor, msg string) {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for load balancer %s to be in %s state", uuid, state))
+
+	if _, err := exec.All().WaitForLoadBalancerOperationalState(exec.Context(), &request.WaitForLoadBalancerOperationalStateRequest{
+		UUID:         uuid,
+		DesiredState: state,
+	}); err != nil {
+		exec.PushProgressUpdate(messages.Update{
+			Key:     msg,
+			Message: msg,
+			Status:  messages.MessageStatusWarning,
+			Details: "Error: " + err.Error(),
+		})
+		return
+	}
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	exec.PushProgressSuccess(msg)
+}
@@ -49,6 +49,12 @@ func Delete(exec commands.Executor, uuid string) (output.Output, error) {
 	return output.None{}, nil
 }
 
+// ResourceName implements commands.DestructiveCommand
+func (s *deleteCommand) ResourceName(uuid string) string {
+	network, _ := s.GetCached(uuid)
+	return network.Name
+}
+
 // Execute implements commands.MultipleArgumentCommand
 func (s *deleteCommand) Execute(exec commands.Executor, arg string) (output.Output, error) {
 	return Delete(exec, arg)
@@ -1,9 +1,12 @@
 package network
 
 import (
+	"strings"
+
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
@@ -96,6 +99,9 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			n.Router,
 			n.Type,
 			n.Zone,
+			ipNetworkAddresses(n.IPNetworks),
+			len(n.Servers),
+			labels.Cell(n.Labels),
 		})
 	}
 
@@ -108,8 +114,19 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "router", Header: "Router", Colour: ui.DefaultUUUIDColours},
 				{Key: "type", Header: "Type"},
 				{Key: "zone", Header: "Zone"},
+				{Key: "ip_networks", Header: "IP networks", Hidden: true},
+				{Key: "servers", Header: "Servers", Hidden: true},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
 	}, nil
 }
+
+func ipNetworkAddresses(ipNetworks upcloud.IPNetworkSlice) string {
+	addresses := make([]string, len(ipNetworks))
+	for i, ipNetwork := range ipNetworks {
+		addresses[i] = ipNetwork.Address
+	}
+	return strings.Join(addresses, ", ")
+}
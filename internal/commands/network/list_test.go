@@ -5,6 +5,7 @@ import (
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
@@ -95,7 +96,7 @@ func createOutput(networks []upcloud.Network) output.MarshaledWithHumanOutput {
 	rows := []output.TableRow{}
 	for _, network := range networks {
 		rows = append(rows,
-			output.TableRow{network.UUID, network.Name, network.Router, network.Type, network.Zone},
+			output.TableRow{network.UUID, network.Name, network.Router, network.Type, network.Zone, "", 0, labels.Cell(network.Labels)},
 		)
 	}
 
@@ -109,6 +110,9 @@ func createOutput(networks []upcloud.Network) output.MarshaledWithHumanOutput {
 				{Header: "Router", Key: "router", Hidden: false, Colour: ui.DefaultUUUIDColours},
 				{Header: "Type", Key: "type", Hidden: false},
 				{Header: "Zone", Key: "zone", Hidden: false},
+				{Header: "IP networks", Key: "ip_networks", Hidden: true},
+				{Header: "Servers", Key: "servers", Hidden: true},
+				{Header: "Labels", Key: "labels", Hidden: true},
 			},
 			Rows: rows,
 		},
@@ -3,6 +3,7 @@ package networkpeering
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 )
@@ -49,6 +50,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			peering.Network.IPNetworks[0].Address,
 			peerNetwork,
 			peering.State,
+			labels.Cell(peering.Labels),
 		})
 	}
 
@@ -62,6 +64,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "network", Header: "Network", Colour: ui.DefaultAddressColours},
 				{Key: "peer_network", Header: "Peer Network", Colour: ui.DefaultAddressColours},
 				{Key: "status", Header: "Status", Format: format.NetworkPeeringState},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
@@ -3,6 +3,7 @@ package objectstorage
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/paging"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
@@ -47,6 +48,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			objectstorage.Region,
 			objectstorage.ConfiguredStatus,
 			objectstorage.OperationalState,
+			labels.Cell(objectstorage.Labels),
 		})
 	}
 
@@ -59,6 +61,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "region", Header: "Region"},
 				{Key: "configured_status", Header: "Configured status", Format: format.ObjectStorageConfiguredStatus},
 				{Key: "operational_state", Header: "Operational state", Format: format.ObjectStorageOperationalState},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
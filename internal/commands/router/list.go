@@ -3,6 +3,7 @@ package router
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
@@ -65,7 +66,7 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 	}
 	rows := make([]output.TableRow, len(filtered))
 	for i, router := range filtered {
-		rows[i] = output.TableRow{router.UUID, router.Name, router.Type}
+		rows[i] = output.TableRow{router.UUID, router.Name, router.Type, labels.Cell(router.Labels)}
 	}
 
 	return output.MarshaledWithHumanOutput{
@@ -75,6 +76,7 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Header: "UUID", Key: "uuid", Colour: ui.DefaultUUUIDColours},
 				{Header: "Name", Key: "name"},
 				{Header: "Type", Key: "type"},
+				{Header: "Labels", Key: "labels", Hidden: true},
 			},
 			Rows: rows,
 		},
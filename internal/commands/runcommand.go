@@ -1,10 +1,13 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
 	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
@@ -14,6 +17,13 @@ func commandRunE(command Command, service internal.AllServices, config *config.C
 	// Cobra validations were successful
 	command.Cobra().SilenceUsage = true
 
+	// In dry-run mode, record mutating API calls instead of executing them
+	var dryRun *internal.DryRun
+	if config.DryRun() && service != nil {
+		dryRun = internal.NewDryRun(service)
+		service = dryRun
+	}
+
 	logger := config.NewLogger("runcommand")
 	cmdLogger := logger.With("command", command.Cobra().CommandPath())
 	executor := NewExecutor(config, service, cmdLogger)
@@ -30,7 +40,7 @@ func commandRunE(command Command, service internal.AllServices, config *config.C
 		if err != nil {
 			return err
 		}
-		return output.Render(w, config.Output(), results...)
+		return RenderOutput(w, config, dryRunOutputs(dryRun, results)...)
 	case SingleArgumentCommand:
 		cmdLogger.Debug("executing single argument", "arguments", args)
 		// make sure we have an argument
@@ -41,9 +51,18 @@ func commandRunE(command Command, service internal.AllServices, config *config.C
 		if err != nil {
 			return err
 		}
-		return output.Render(w, config.Output(), results...)
+		return RenderOutput(w, config, dryRunOutputs(dryRun, results)...)
 	case MultipleArgumentCommand:
 		cmdLogger.Debug("executing multi argument", "arguments", args)
+		opts, err := tableOptions(config)
+		if err != nil {
+			return err
+		}
+		// Use label selector to select the targeted resources, if the command resolves its arguments
+		if _, ok := typedCommand.(resolver.ResolutionProvider); ok && config.Selector() != "" {
+			args = append(args, resolver.LabelSelectorPrefix+config.Selector())
+			opts.Selector = nil
+		}
 		// make sure we have arguments
 		if len(args) < 1 {
 			return fmt.Errorf("at least one positional argument is required")
@@ -52,7 +71,7 @@ func commandRunE(command Command, service internal.AllServices, config *config.C
 		if err != nil {
 			return err
 		}
-		return output.Render(w, config.Output(), results...)
+		return renderOutput(w, config, opts, dryRunOutputs(dryRun, results)...)
 	default:
 		// no execution found on this command, eg. most likely an 'organizational' command
 		// so just show usage
@@ -61,6 +80,43 @@ func commandRunE(command Command, service internal.AllServices, config *config.C
 	}
 }
 
+// RenderOutput renders the command outputs with the user specified output format and table options.
+func RenderOutput(w io.Writer, cfg *config.Config, outputs ...output.Output) error {
+	opts, err := tableOptions(cfg)
+	if err != nil {
+		return err
+	}
+	return renderOutput(w, cfg, opts, outputs...)
+}
+
+func renderOutput(w io.Writer, cfg *config.Config, opts output.TableOptions, outputs ...output.Output) error {
+	outputs, err := output.ApplyTableOptions(opts, outputs...)
+	if err != nil {
+		return err
+	}
+	return output.Render(w, cfg.Output(), outputs...)
+}
+
+func tableOptions(cfg *config.Config) (output.TableOptions, error) {
+	filters, err := filter.ParseAll(cfg.Filters())
+	if err != nil {
+		return output.TableOptions{}, err
+	}
+	selector, err := filter.ParseSelector(cfg.Selector())
+	if err != nil {
+		return output.TableOptions{}, err
+	}
+
+	return output.TableOptions{
+		Columns:  cfg.Columns(),
+		SortBy:   cfg.SortBy(),
+		Filters:  filters,
+		Selector: selector,
+		// Human-readable formats render only the table, so the marshaled value is left as is.
+		FilterValue: output.RendersValue(cfg.Output()),
+	}, nil
+}
+
 type resolvedArgument struct {
 	Resolved string
 	Error    error
@@ -102,6 +158,10 @@ func resolveArguments(nc Command, exec Executor, args []string, mode resolveMode
 				values, err := resolved.GetAll()
 				if err != nil {
 					out = append(out, resolvedArgument{Resolved: "", Error: err, Original: arg})
+				} else if resolver.IsLabelSelector(arg) {
+					if err := confirmLabelSelection(nc, exec, arg, values); err != nil {
+						return nil, err
+					}
 				}
 				for _, value := range values {
 					out = append(out, resolvedArgument{Resolved: value, Error: err, Original: arg})
@@ -126,6 +186,12 @@ func execute(command Command, executor Executor, args []string, mode resolveMode
 		return nil, fmt.Errorf("cannot resolve command line arguments: %w", err)
 	}
 
+	if destructive, ok := command.(DestructiveCommand); ok && mode == resolveAll {
+		if err := confirmDestructive(destructive, executor.Config(), resolvedArgs); err != nil {
+			return nil, err
+		}
+	}
+
 	returnChan := make(chan executeResult)
 	workerCount := parallelRuns
 	workerQueue := make(chan int, workerCount)
@@ -178,7 +244,11 @@ func execute(command Command, executor Executor, args []string, mode resolveMode
 			}(workerID, arg)
 		case res := <-returnChan:
 			// got a result from a worker
-			if res.Error != nil {
+			var checkErr clierrors.CheckFailedError
+			if res.Error != nil && res.Result != nil && errors.As(res.Error, &checkErr) {
+				// Output of a failed check is rendered normally, the error only affects the exit code
+				outputs = append(outputs, res.Result, output.Failure{Value: checkErr})
+			} else if res.Error != nil {
 				outputs = append(outputs, output.Error{
 					Value:    res.Error,
 					Resolved: res.ResolvedArgument.Resolved,
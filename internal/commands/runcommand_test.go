@@ -8,12 +8,15 @@ import (
 	"os"
 	"testing"
 
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
 	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
 
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -297,3 +300,98 @@ func TestExecute_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestExecute_CheckFailed(t *testing.T) {
+	cmd := &mockMulti{Command: &cobra.Command{}}
+	checkErr := clierrors.CheckFailedError{Err: fmt.Errorf("threshold exceeded")}
+	cmd.On("Execute", mock.Anything, "a").Return(output.OnlyMarshaled{Value: "mock"}, checkErr)
+	mService := &smock.Service{}
+	cfg := config.New()
+	cfg.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	executor := NewExecutor(cfg, mService, cfg.NewLogger("test"))
+	outputs, err := execute(cmd, executor, []string{"a"}, resolveOnly, 10, cmd.Execute)
+	assert.NoError(t, err)
+	assert.Equal(t, []output.Output{output.OnlyMarshaled{Value: "mock"}, output.Failure{Value: checkErr}}, outputs)
+
+	stdout := new(bytes.Buffer)
+	err = RenderOutput(stdout, cfg, outputs...)
+	assert.Equal(t, checkErr, err)
+	assert.Equal(t, "\"mock\"\n", stdout.String())
+}
+
+func TestRunCommand_DryRun(t *testing.T) {
+	cmd := &mockMulti{Command: &cobra.Command{}}
+	cmd.On("Execute", mock.Anything, "uuid-1").Run(func(args mock.Arguments) {
+		exec := args.Get(0).(Executor)
+		_, err := exec.Server().StopServer(exec.Context(), &request.StopServerRequest{UUID: "uuid-1"})
+		assert.NoError(t, err)
+	}).Return(output.OnlyMarshaled{Value: "mock"}, nil)
+	cmd.On("Execute", mock.Anything, "uuid-2").Return(output.OnlyMarshaled{}, fmt.Errorf("failed"))
+	stdout := new(bytes.Buffer)
+	cmd.Cobra().SetOut(stdout)
+
+	mService := &smock.Service{}
+	cfg := config.New()
+	cfg.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	cfg.Viper().Set(config.KeyDryRun, true)
+
+	err := commandRunE(cmd, mService, cfg, []string{"uuid-1", "uuid-2"})
+	assert.EqualError(t, err, "Command execution failed for 1 resource(s)")
+	mService.AssertNotCalled(t, "StopServer")
+	assert.Contains(t, stdout.String(), `"method": "StopServer"`)
+	assert.Contains(t, stdout.String(), `"url": "/server/uuid-1/stop"`)
+	assert.NotContains(t, stdout.String(), `"mock"`)
+}
+
+func TestRunCommand_DryRunRedactsSecrets(t *testing.T) {
+	for _, format := range []string{config.ValueOutputHuman, config.ValueOutputJSON, config.ValueOutputYAML} {
+		t.Run(format, func(t *testing.T) {
+			cmd := &mockMulti{Command: &cobra.Command{}}
+			cmd.On("Execute", mock.Anything, "gateway-uuid").Run(func(args mock.Arguments) {
+				exec := args.Get(0).(Executor)
+				_, err := exec.All().CreateGatewayConnectionTunnel(exec.Context(), &request.CreateGatewayConnectionTunnelRequest{
+					ServiceUUID:    "gateway-uuid",
+					ConnectionUUID: "connection-uuid",
+					Tunnel: request.GatewayTunnel{
+						Name: "tunnel-1",
+						IPSec: upcloud.GatewayTunnelIPSec{
+							Authentication: upcloud.GatewayTunnelIPSecAuth{
+								Authentication: upcloud.GatewayTunnelIPSecAuthTypePSK,
+								PSK:            "very-secret-psk",
+							},
+						},
+					},
+				})
+				assert.NoError(t, err)
+			}).Return(output.OnlyMarshaled{Value: "mock"}, nil)
+			stdout := new(bytes.Buffer)
+			cmd.Cobra().SetOut(stdout)
+
+			mService := &smock.Service{}
+			cfg := config.New()
+			cfg.Viper().Set(config.KeyOutput, format)
+			cfg.Viper().Set(config.KeyDryRun, true)
+
+			err := commandRunE(cmd, mService, cfg, []string{"gateway-uuid"})
+			assert.NoError(t, err)
+			assert.Contains(t, stdout.String(), "tunnel-1")
+			assert.Contains(t, stdout.String(), "[redacted]")
+			assert.NotContains(t, stdout.String(), "very-secret-psk")
+		})
+	}
+}
+
+func TestRedactRequest(t *testing.T) {
+	redacted := redactRequest(&request.CreateLoadBalancerCertificateBundleRequest{
+		Type:        upcloud.LoadBalancerCertificateBundleTypeManual,
+		Name:        "bundle",
+		Certificate: "certificate",
+		PrivateKey:  "private-key",
+	})
+	assert.Equal(t, map[string]any{
+		"type":        "manual",
+		"name":        "bundle",
+		"certificate": "certificate",
+		"private_key": "[redacted]",
+	}, redacted)
+}
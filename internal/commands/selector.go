@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+)
+
+// labelSelectorConfirmThreshold is the number of resources a label selector argument can match before the user is asked to confirm the operation.
+const labelSelectorConfirmThreshold = 10
+
+// confirmLabelSelection reports the resources matched by a label selector argument. If the number of matches exceeds labelSelectorConfirmThreshold, the user is asked to confirm the operation unless it is confirmed with `--yes` flag or by the confirmation prompt of a destructive command.
+func confirmLabelSelection(nc Command, exec Executor, arg string, uuids []string) error {
+	uuids = slices.Sorted(slices.Values(uuids))
+	selector := strings.TrimPrefix(arg, resolver.LabelSelectorPrefix)
+	msg := fmt.Sprintf("Label selector %s matched %d resource(s)", selector, len(uuids))
+
+	// Destructive commands and `--yes` flag confirm the operation, so there is no need to prompt for the number of matches
+	_, destructive := nc.(DestructiveCommand)
+	if len(uuids) <= labelSelectorConfirmThreshold || destructive || exec.Config().AssumeYes() {
+		exec.PushProgressUpdate(messages.Update{
+			Key:     arg,
+			Message: msg,
+			Status:  messages.MessageStatusSuccess,
+			Details: strings.Join(uuids, "\n"),
+		})
+		return nil
+	}
+
+	if !isInteractive() {
+		return fmt.Errorf("label selector %s matched %d resources, which is more than %d. Confirming the operation requires an interactive terminal or --yes flag", selector, len(uuids), labelSelectorConfirmThreshold)
+	}
+
+	w := nc.Cobra().ErrOrStderr()
+	fmt.Fprintf(w, "%s:\n  %s\n", msg, strings.Join(uuids, "\n  "))
+	confirmed, err := promptConfirmation(nc.Cobra().InOrStdin(), w, fmt.Sprintf("Continue with %d resources?", len(uuids)))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errNotConfirmed
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLabelResolver resolves label selector arguments to the given number of matches.
+type mockLabelResolver struct {
+	*mockMultiResolver
+	matches int
+}
+
+func (m *mockLabelResolver) Get(_ context.Context, _ internal.AllServices) (resolver.Resolver, error) {
+	return func(arg string) resolver.Resolved {
+		rv := resolver.Resolved{Arg: arg}
+		if resolver.IsLabelSelector(arg) {
+			for i := range m.matches {
+				rv.AddMatch(fmt.Sprintf("uuid:%d", i), resolver.MatchTypeLabelSelector)
+			}
+		}
+		return rv
+	}, nil
+}
+
+func TestExecute_LabelSelector(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		matches     int
+		interactive bool
+		input       string
+		expectedErr string
+	}{
+		{
+			name:    "below threshold",
+			matches: labelSelectorConfirmThreshold,
+		},
+		{
+			name:        "above threshold, non-interactive",
+			matches:     labelSelectorConfirmThreshold + 1,
+			expectedErr: "cannot resolve command line arguments: label selector env=prod matched 11 resources, which is more than 10. Confirming the operation requires an interactive terminal or --yes flag",
+		},
+		{
+			name:        "above threshold, confirmed",
+			matches:     labelSelectorConfirmThreshold + 1,
+			interactive: true,
+			input:       "yes\n",
+		},
+		{
+			name:        "above threshold, not confirmed",
+			matches:     labelSelectorConfirmThreshold + 1,
+			interactive: true,
+			input:       "n\n",
+			expectedErr: "cannot resolve command line arguments: operation was not confirmed",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			origIsInteractive := isInteractive
+			isInteractive = func() bool { return test.interactive }
+			defer func() { isInteractive = origIsInteractive }()
+
+			cmd := &mockLabelResolver{mockMultiResolver: &mockMultiResolver{Command: &cobra.Command{}}, matches: test.matches}
+			cmd.Cobra().SetIn(strings.NewReader(test.input))
+			stderr := new(bytes.Buffer)
+			cmd.Cobra().SetErr(stderr)
+
+			cfg := config.New()
+			executor := NewExecutor(cfg, &smock.Service{}, cfg.NewLogger("test"))
+			outputs, err := execute(cmd, executor, []string{"label:env=prod"}, resolveAll, 10, func(_ Executor, arg string) (output.Output, error) {
+				return output.OnlyMarshaled{Value: arg}, nil
+			})
+
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, outputs, test.matches)
+			if test.interactive {
+				assert.Contains(t, stderr.String(), "Continue with 11 resources? [y/N]")
+			}
+		})
+	}
+}
+
+// wrappedExecutor wraps an executor, e.g., to override some of its methods, so it is not an *executorImpl.
+type wrappedExecutor struct {
+	Executor
+}
+
+func TestExecute_LabelSelectorYesWithWrappedExecutor(t *testing.T) {
+	origIsInteractive := isInteractive
+	isInteractive = func() bool { return false }
+	defer func() { isInteractive = origIsInteractive }()
+
+	cmd := &mockLabelResolver{mockMultiResolver: &mockMultiResolver{Command: &cobra.Command{}}, matches: labelSelectorConfirmThreshold + 1}
+	cfg := config.New()
+	cfg.Viper().Set(config.KeyYes, true)
+	executor := wrappedExecutor{NewExecutor(cfg, &smock.Service{}, cfg.NewLogger("test"))}
+	outputs, err := execute(cmd, executor, []string{"label:env=prod"}, resolveAll, 10, func(_ Executor, arg string) (output.Output, error) {
+		return output.OnlyMarshaled{Value: arg}, nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, outputs, labelSelectorConfirmThreshold+1)
+}
+
+func TestRunCommand_Selector(t *testing.T) {
+	cmd := &mockLabelResolver{mockMultiResolver: &mockMultiResolver{Command: &cobra.Command{}}, matches: 2}
+	cmd.On("Execute", mock.Anything, mock.Anything).Return(output.OnlyMarshaled{Value: "mock"}, nil)
+	stdout := new(bytes.Buffer)
+	cmd.Cobra().SetOut(stdout)
+
+	cfg := config.New()
+	cfg.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	cfg.Viper().Set(config.KeySelector, "env=prod")
+
+	err := commandRunE(cmd, &smock.Service{}, cfg, []string{})
+	require.NoError(t, err)
+	cmd.AssertCalled(t, "Execute", mock.Anything, "uuid:0")
+	cmd.AssertCalled(t, "Execute", mock.Anything, "uuid:1")
+	assert.Equal(t, "[\n  \"mock\",\n  \"mock\"\n]\n", stdout.String())
+}
@@ -23,6 +23,8 @@ func DeleteCommand() commands.Command {
 			"upctl server delete 00cbe2f3-4cf9-408b-afee-bd340e13cdd8",
 			"upctl server delete 00cbe2f3-4cf9-408b-afee-bd340e13cdd8 0053a6f5-e6d1-4b0b-b9dc-b90d0894e8d0",
 			"upctl server delete my_server",
+			"upctl server delete my_server --confirm-name",
+			"upctl server delete 00cbe2f3-4cf9-408b-afee-bd340e13cdd8 --yes",
 		),
 	}
 }
@@ -75,6 +77,12 @@ func Delete(exec commands.Executor, uuid, state string, deleteStorages, stopServ
 	return output.None{}, nil
 }
 
+// ResourceName implements commands.DestructiveCommand
+func (s *deleteCommand) ResourceName(uuid string) string {
+	server, _ := s.GetCached(uuid)
+	return server.Hostname
+}
+
 // Execute implements commands.MultipleArgumentCommand
 func (s *deleteCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
 	server, _ := s.GetCached(uuid)
@@ -2,13 +2,18 @@ package server
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/UpCloudLtd/progress/messages"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/network"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
@@ -41,15 +46,27 @@ type serverWithIPAddress struct {
 	upcloud.Server
 
 	IPAddresses upcloud.IPAddressSlice `json:"ip_addresses"`
+	Labels      upcloud.LabelSlice     `json:"labels,omitempty"`
 }
 
 type serversWithIPAddresses struct {
 	Servers []serverWithIPAddress `json:"servers"`
 }
 
+type serverWithLabels struct {
+	upcloud.Server
+
+	Labels upcloud.LabelSlice `json:"labels"`
+}
+
+type serversWithLabels struct {
+	Servers []serverWithLabels `json:"servers"`
+}
+
 type listCommand struct {
 	*commands.BaseCommand
 	showIPAddresses string
+	cfg             *config.Config
 }
 
 // InitCommand implements Command.InitCommand
@@ -62,6 +79,11 @@ func (ls *listCommand) InitCommand() {
 	commands.Must(ls.Cobra().RegisterFlagCompletionFunc("show-ip-addresses", cobra.FixedCompletions(accessTypes, cobra.ShellCompDirectiveNoFileComp)))
 }
 
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (ls *listCommand) InitCommandWithConfig(cfg *config.Config) {
+	ls.cfg = cfg
+}
+
 // ExecuteWithoutArguments implements commands.NoArgumentCommand
 func (ls *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
 	svc := exec.All()
@@ -70,6 +92,27 @@ func (ls *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.O
 		return nil, err
 	}
 
+	// Labels and prices are not included in the server list, so they are fetched only when the columns are shown or labels are needed for --selector.
+	var serverLabels map[string][]upcloud.Label
+	if ls.cfg.ColumnShown("labels") || ls.cfg.Selector() != "" {
+		serverLabels, err = labels.ServerLabels(exec.Context(), svc, servers.Servers)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var prices *upcloud.PricesByZone
+	if ls.cfg.ColumnShown("plan_price") {
+		prices, err = svc.GetPricesByZone(exec.Context())
+		if err != nil {
+			exec.PushProgressUpdate(messages.Update{
+				Message: "Getting prices information failed. Servers are displayed without price details",
+				Status:  messages.MessageStatusWarning,
+				Details: "Error: " + err.Error(),
+			})
+		}
+	}
+	month, _ := getDuration(durationMonth)
+
 	rows := []output.TableRow{}
 	for _, s := range servers.Servers {
 		plan := s.Plan
@@ -84,6 +127,12 @@ func (ls *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.O
 			plan,
 			s.Zone,
 			s.State,
+			s.Title,
+			s.CoreNumber,
+			s.MemoryAmount,
+			strings.Join(s.Tags, ", "),
+			labels.Cell(serverLabels[s.UUID]),
+			serverPlanCost(s, prices, month),
 		})
 	}
 
@@ -93,6 +142,12 @@ func (ls *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.O
 		{Key: "plan", Header: "Plan"},
 		{Key: "zone", Header: "Zone"},
 		{Key: "state", Header: "State", Format: format.ServerState},
+		{Key: "title", Header: "Title", Hidden: true},
+		{Key: "core_number", Header: "Cores", Hidden: true},
+		{Key: "memory_amount", Header: "Memory (MiB)", Hidden: true},
+		{Key: "tags", Header: "Tags", Hidden: true},
+		{Key: "labels", Header: "Labels", Hidden: true},
+		{Key: "plan_price", Header: "Plan price (per month)", Format: getFormatPrice(2), Hidden: true},
 	}
 
 	if ls.showIPAddresses != "none" {
@@ -118,6 +173,7 @@ func (ls *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.O
 			serversWithIPs.Servers = append(serversWithIPs.Servers, serverWithIPAddress{
 				Server:      servers.Servers[i],
 				IPAddresses: listIpaddresses,
+				Labels:      serverLabels[uuid],
 			})
 		}
 		columns = append(columns[:3], columns[2:]...)
@@ -136,8 +192,17 @@ func (ls *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.O
 		}, nil
 	}
 
+	var value any = servers
+	if serverLabels != nil {
+		withLabels := serversWithLabels{Servers: make([]serverWithLabels, len(servers.Servers))}
+		for i, server := range servers.Servers {
+			withLabels.Servers[i] = serverWithLabels{Server: server, Labels: serverLabels[server.UUID]}
+		}
+		value = withLabels
+	}
+
 	return output.MarshaledWithHumanOutput{
-		Value: servers,
+		Value: value,
 		Output: output.Table{
 			Columns: columns,
 			Rows:    rows,
@@ -145,6 +210,14 @@ func (ls *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.O
 	}, nil
 }
 
+// serverPlanCost returns the price of the plan of the server in the zone of the server for the given duration. Prices of custom plans are unknown.
+func serverPlanCost(server upcloud.Server, prices *upcloud.PricesByZone, duration time.Duration) float64 {
+	if prices == nil {
+		return math.NaN()
+	}
+	return getPlanCost(upcloud.Plan{Name: server.Plan}, (*prices)[server.Zone], duration)
+}
+
 // getIPAddressesByServerUUID returns IP addresses grouped by server UUID. This function will be removed when server end-point response includes IP addresses.
 func getIPAddressesByServerUUID(servers *upcloud.Servers, accessType string, exec commands.Executor) (map[string]listServerIpaddresses, error) {
 	returnChan := make(chan listServerIpaddresses)
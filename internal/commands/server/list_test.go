@@ -12,6 +12,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 const expectedJSONOutput = `
@@ -143,12 +144,22 @@ func TestListServers(t *testing.T) {
 		},
 	}
 
+	serverDetails := upcloud.ServerDetails{
+		Server: servers.Servers[0],
+		Labels: upcloud.LabelSlice{{Key: "env", Value: "prod"}},
+	}
+	prices := upcloud.PricesByZone{
+		"pl-waw1": {"server_plan_1xCPU-1GB": {Amount: 1, Price: 0.744}},
+	}
+
 	ipaddressesTitle := "IP addresses"
 
 	for _, test := range []struct {
 		name              string
 		args              []string
 		json              bool
+		wide              bool
+		selector          string
 		outputContains    []string
 		outputNotContains []string
 		outputJSONEquals  string
@@ -159,6 +170,7 @@ func TestListServers(t *testing.T) {
 			outputNotContains: []string{
 				ipaddressesTitle,
 				"10.0.98.3",
+				"Memory (MiB)",
 			},
 		},
 		{
@@ -198,6 +210,34 @@ func TestListServers(t *testing.T) {
 				"utility: 10.0.100.1",
 			},
 		},
+		{
+			name: "Wide output",
+			args: []string{},
+			wide: true,
+			outputContains: []string{
+				"Cores",
+				"Memory (MiB)",
+				"Tags",
+				"Labels",
+				"env=prod",
+				"Plan price (per month)",
+				"5.00",
+			},
+		},
+		{
+			name:     "Selector matches labels",
+			json:     true,
+			selector: "env=prod",
+			outputContains: []string{
+				`"hostname": "server-list-test-server"`,
+				`"key": "env"`,
+			},
+		},
+		{
+			name:              "Selector does not match labels",
+			selector:          "env!=prod",
+			outputNotContains: []string{"server-list-test-server"},
+		},
 		{
 			name:             "JSON output",
 			args:             []string{"--show-ip-addresses"},
@@ -210,12 +250,20 @@ func TestListServers(t *testing.T) {
 			if test.json {
 				conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
 			}
+			if test.wide {
+				conf.Viper().Set(config.KeyOutput, config.ValueOutputWide)
+			}
+			if test.selector != "" {
+				conf.Viper().Set(config.KeySelector, test.selector)
+			}
 
 			testCmd := ListCommand()
 			mService := new(smock.Service)
 
 			mService.On("GetServers").Return(&servers, nil)
 			mService.On("GetServerNetworks", &request.GetServerNetworksRequest{ServerUUID: uuid}).Return(&serverNetworks, nil)
+			mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: uuid}).Return(&serverDetails, nil)
+			mService.On("GetPricesByZone").Return(&prices, nil)
 
 			c := commands.BuildCommand(testCmd, nil, conf)
 			c.Cobra().SetArgs(test.args)
@@ -231,6 +279,13 @@ func TestListServers(t *testing.T) {
 				assert.NotContains(t, output, notContains)
 			}
 
+			if !test.wide {
+				if test.selector == "" {
+					mService.AssertNotCalled(t, "GetServerDetails", mock.Anything)
+				}
+				mService.AssertNotCalled(t, "GetPricesByZone")
+			}
+
 			if len(test.outputJSONEquals) > 0 {
 				assert.JSONEq(t, test.outputJSONEquals, output)
 			}
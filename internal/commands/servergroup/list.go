@@ -3,6 +3,7 @@ package servergroup
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 
@@ -58,6 +59,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			serverGroup.AntiAffinityPolicy,
 			groupStatus,
 			len(serverGroup.Members),
+			labels.Cell(serverGroup.Labels),
 		})
 	}
 
@@ -71,6 +73,7 @@ func (c *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "anti_affinity", Header: "Anti-affinity policy"},
 				{Key: "anti_affinity_status", Header: "Anti-affinity status", Format: format.ServerGroupAntiAffinityState},
 				{Key: "server_count", Header: "Server count"},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
@@ -74,6 +74,12 @@ func Delete(exec commands.Executor, uuid string, backups string) (output.Output,
 	return output.None{}, nil
 }
 
+// ResourceName implements commands.DestructiveCommand
+func (s *deleteCommand) ResourceName(uuid string) string {
+	storage, _ := s.GetCached(uuid)
+	return storage.Title
+}
+
 // Execute implements commands.MultipleArgumentCommand
 func (s *deleteCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
 	return Delete(exec, uuid, s.backups)
@@ -4,6 +4,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 
@@ -107,6 +108,7 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 			storage.Zone,
 			storage.Access,
 			storage.Created,
+			labels.Cell(storage.Labels),
 		})
 	}
 
@@ -123,6 +125,8 @@ func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Ou
 				{Key: "tier", Header: "Tier"},
 				{Key: "zone", Header: "Zone"},
 				{Key: "access", Header: "Access"},
+				{Key: "created", Header: "Created", Hidden: true},
+				{Key: "labels", Header: "Labels", Hidden: true},
 			},
 			Rows: rows,
 		},
@@ -26,3 +26,23 @@ func (s Gateway) CompleteArgument(ctx context.Context, svc service.AllServices,
 
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// GatewayPlan implements argument completion for gateway plans.
+type GatewayPlan struct{}
+
+// make sure GatewayPlan implements the interface.
+var _ Provider = GatewayPlan{}
+
+// CompleteArgument implements completion.Provider.
+func (s GatewayPlan) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	plans, err := svc.GetGatewayPlans(ctx)
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, plan := range plans {
+		vals = append(vals, plan.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
@@ -26,3 +26,24 @@ func (s IPAddress) CompleteArgument(ctx context.Context, svc service.AllServices
 	}
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// FloatingIPAddress implements argument completion for floating ip addresses, by the address itself
+type FloatingIPAddress struct{}
+
+// make sure FloatingIPAddress implements the interface
+var _ Provider = FloatingIPAddress{}
+
+// CompleteArgument implements completion.Provider
+func (s FloatingIPAddress) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ipAddresses, err := svc.GetIPAddresses(ctx)
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, v := range ipAddresses.IPAddresses {
+		if v.Floating.Bool() {
+			vals = append(vals, v.Address)
+		}
+	}
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
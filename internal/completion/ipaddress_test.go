@@ -49,3 +49,17 @@ func TestIPAddress_CompleteArgumentServiceFail(t *testing.T) {
 	assert.Nil(t, ips)
 	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
 }
+
+func TestFloatingIPAddress_CompleteArgument(t *testing.T) {
+	floatingIPs := &upcloud.IPAddresses{IPAddresses: []upcloud.IPAddress{
+		{Address: "94.237.0.10", Floating: upcloud.True},
+		{Address: "94.237.0.11", Floating: upcloud.False},
+		{Address: "94.237.0.12", Floating: upcloud.True},
+	}}
+
+	mService := new(smock.Service)
+	mService.On("GetIPAddresses", mock.Anything).Return(floatingIPs, nil)
+	ips, directive := completion.FloatingIPAddress{}.CompleteArgument(context.TODO(), mService, "94.237")
+	assert.Equal(t, []string{"94.237.0.10", "94.237.0.12"}, ips)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
@@ -27,3 +27,43 @@ func (s LoadBalancer) CompleteArgument(ctx context.Context, svc service.AllServi
 
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// LoadBalancerPlan implements argument completion for load balancer plans.
+type LoadBalancerPlan struct{}
+
+// make sure LoadBalancerPlan implements the interface.
+var _ Provider = LoadBalancerPlan{}
+
+// CompleteArgument implements completion.Provider.
+func (s LoadBalancerPlan) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	plans, err := svc.GetLoadBalancerPlans(ctx, &request.GetLoadBalancerPlansRequest{})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, plan := range plans {
+		vals = append(vals, plan.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// CertificateBundle implements argument completion for load balancer certificate bundles, by uuid or name.
+type CertificateBundle struct{}
+
+// make sure CertificateBundle implements the interface
+var _ Provider = CertificateBundle{}
+
+// CompleteArgument implements completion.Provider
+func (s CertificateBundle) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	certBundles, err := svc.GetLoadBalancerCertificateBundles(ctx, &request.GetLoadBalancerCertificateBundlesRequest{})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, cb := range certBundles {
+		vals = append(vals, cb.UUID, cb.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log/slog"
@@ -8,10 +9,12 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime/debug"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
 	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
 	"github.com/zalando/go-keyring"
 
@@ -21,22 +24,54 @@ import (
 	"github.com/adrg/xdg"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
 )
 
 const (
 	// KeyClientTimeout defines the viper configuration key used to define client timeout
 	KeyClientTimeout = "client-timeout"
+	// KeyColumns defines the viper configuration key used to define the columns of table output
+	KeyColumns = "columns"
+	// KeyDryRun defines the viper configuration key used to enable dry-run mode
+	KeyDryRun = "dry-run"
+	// KeyFilter defines the viper configuration key used to define the filters of table output
+	KeyFilter = "filter"
+	// KeyMyIP defines the viper configuration key used to define the IP address of the user, e.g., for allowing access to resources with IP filters
+	KeyMyIP = "my-ip"
 	// KeyOutput defines the viper configuration key used to define the output
 	KeyOutput = "output"
+	// KeyProfile defines the viper configuration key used to select the active profile
+	KeyProfile = "profile"
+	// KeyProfiles defines the viper configuration key that contains the named profiles
+	KeyProfiles = "profiles"
+	// KeySelector defines the viper configuration key used to define the label selector of table output
+	KeySelector = "selector"
+	// KeySortBy defines the viper configuration key used to define the column table output is sorted by
+	KeySortBy = "sort-by"
+	// KeyYes defines the viper configuration key used to confirm operations without prompting
+	KeyYes = "yes"
+	// KeyZone defines the viper configuration key used to define the default zone
+	KeyZone = "zone"
 	// ValueOutputHuman defines the viper configuration value used to define human-readable output
 	ValueOutputHuman = "human"
+	// ValueOutputWide defines the viper configuration value used to define human-readable output with additional columns
+	ValueOutputWide = "wide"
 	// ValueOutputYAML defines the viper configuration value used to define YAML output
 	ValueOutputYAML = "yaml"
 	// ValueOutputJSON defines the viper configuration value used to define JSON output
 	ValueOutputJSON = "json"
+	// ValueOutputCSV defines the viper configuration value used to define CSV output
+	ValueOutputCSV = "csv"
+	// ValueOutputTSV defines the viper configuration value used to define TSV output
+	ValueOutputTSV = "tsv"
+	// ValueOutputMarkdown defines the viper configuration value used to define Markdown table output
+	ValueOutputMarkdown = "markdown"
 
 	// env vars custom prefix
 	envPrefix = "UPCLOUD"
+
+	// keyring user prefix for tokens of named profiles
+	keyringProfileUserPrefix = "profile/"
 )
 
 var (
@@ -57,7 +92,14 @@ type GlobalFlags struct {
 	ConfigFile    string        `valid:"-"`
 	ClientTimeout time.Duration `valid:"-"`
 	Debug         bool          `valid:"-"`
-	OutputFormat  string        `valid:"in(human|json|yaml)"`
+	DryRun        bool          `valid:"-"`
+	AssumeYes     bool          `valid:"-"`
+	OutputFormat  string        `valid:"-"`
+	Columns       []string      `valid:"-"`
+	SortBy        string        `valid:"-"`
+	Filters       []string      `valid:"-"`
+	Selector      string        `valid:"-"`
+	Profile       string        `valid:"-"`
 	NoColours     OptionalBoolean
 	ForceColours  OptionalBoolean
 }
@@ -97,11 +139,24 @@ func (s *Config) Load() error {
 		}
 	}
 
-	creds, err := credentials.Parse(credentials.Credentials{
+	profile := v.GetString(KeyProfile)
+	if profile != "" {
+		if err := s.applyProfile(profile); err != nil {
+			return err
+		}
+	}
+
+	creds := credentials.Credentials{
 		Username: v.GetString("username"),
 		Password: v.GetString("password"),
 		Token:    v.GetString("token"),
-	})
+	}
+	var err error
+	if profile != "" {
+		creds, err = parseProfileCredentials(profile, creds)
+	} else {
+		creds, err = credentials.Parse(creds)
+	}
 	if err == nil {
 		v.Set("username", creds.Username)
 		v.Set("password", creds.Password)
@@ -112,11 +167,13 @@ func (s *Config) Load() error {
 
 	settings := v.AllSettings()
 	// sanitize password before logging settings
-	if _, ok := settings["password"]; ok {
-		settings["password"] = "[REDACTED]"
-	}
-	if _, ok := settings["token"]; ok {
-		settings["token"] = "[REDACTED]"
+	redactCredentials(settings)
+	if profiles, ok := settings[KeyProfiles].(map[string]any); ok {
+		for _, profile := range profiles {
+			if profile, ok := profile.(map[string]any); ok {
+				redactCredentials(profile)
+			}
+		}
 	}
 
 	logger := s.NewLogger("config")
@@ -125,6 +182,63 @@ func (s *Config) Load() error {
 	return nil
 }
 
+// applyProfile merges the settings of the named profile on top of the top-level settings read from the config file.
+// Environment variables and flags still take precedence over the profile settings.
+func (s *Config) applyProfile(name string) error {
+	v := s.Viper()
+
+	settings := v.GetStringMap(KeyProfiles + "." + name)
+	if !v.IsSet(KeyProfiles + "." + name) {
+		// Allow using an undefined profile, e.g., to save a token for it with `account login`.
+		logger := s.NewLogger("config")
+		logger.Debug("profile not defined in config file", "profile", name)
+	}
+
+	// Credentials defined outside of the profile should not be used with the profile.
+	for _, key := range []string{"username", "password", "token"} {
+		if _, ok := settings[key]; !ok {
+			settings[key] = ""
+		}
+	}
+
+	if err := v.MergeConfigMap(settings); err != nil {
+		return fmt.Errorf("unable to apply profile '%s': %w", name, err)
+	}
+	return nil
+}
+
+// parseProfileCredentials completes the credentials of the named profile from the system keyring. Unlike
+// credentials.Parse, it does not fall back to the default token in the keyring as that could belong to another account.
+func parseProfileCredentials(profile string, creds credentials.Credentials) (credentials.Credentials, error) {
+	if creds.Token != "" {
+		return credentials.Credentials{Token: creds.Token}, nil
+	}
+	if creds.Username != "" && creds.Password != "" {
+		return creds, nil
+	}
+
+	if creds.Username != "" {
+		if password, err := keyring.Get(credentials.KeyringServiceName, creds.Username); err == nil {
+			creds.Password = password
+			return creds, nil
+		}
+	}
+
+	token, err := keyring.Get(credentials.KeyringServiceName, keyringTokenUser(profile))
+	if err != nil {
+		return creds, fmt.Errorf("credentials not found for profile '%s': %w", profile, err)
+	}
+	return credentials.Credentials{Token: token}, nil
+}
+
+func redactCredentials(settings map[string]any) {
+	for _, key := range []string{"password", "token"} {
+		if _, ok := settings[key]; ok {
+			settings[key] = "[REDACTED]"
+		}
+	}
+}
+
 // Viper returns a reference to the viper instance
 func (s *Config) Viper() *viper.Viper {
 	return s.viper
@@ -183,9 +297,138 @@ func (s *Config) Output() string {
 	return s.viper.GetString(KeyOutput)
 }
 
-// OutputHuman is a convenience method that returns true if the user specified human-readable output
+// OutputHuman is a convenience method that returns true if the user specified human-readable output, i.e. human or wide
 func (s *Config) OutputHuman() bool {
-	return s.Output() == ValueOutputHuman
+	return s.Output() == ValueOutputHuman || s.Output() == ValueOutputWide
+}
+
+// Columns is a convenience method for getting the user specified table columns
+func (s *Config) Columns() []string {
+	return s.viper.GetStringSlice(KeyColumns)
+}
+
+// SortBy is a convenience method for getting the user specified table sort column
+func (s *Config) SortBy() string {
+	return s.viper.GetString(KeySortBy)
+}
+
+// Filters is a convenience method for getting the user specified table filters
+func (s *Config) Filters() []string {
+	return s.viper.GetStringSlice(KeyFilter)
+}
+
+// Selector is a convenience method for getting the user specified label selector
+func (s *Config) Selector() string {
+	return s.viper.GetString(KeySelector)
+}
+
+// ColumnShown is a convenience method that returns true if the table column with the given key is used in the output, i.e. the output format is wide or the column is included in --columns, --sort-by, or --filter flags.
+// Commands can use this to fetch the values of hidden columns only when they are needed.
+func (s *Config) ColumnShown(key string) bool {
+	if s.Output() == ValueOutputWide || slices.Contains(s.Columns(), key) || strings.TrimPrefix(s.SortBy(), "-") == key {
+		return true
+	}
+
+	filters, err := filter.ParseAll(s.Filters())
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(filters, func(f filter.Filter) bool { return f.Key == key })
+}
+
+// DryRun is a convenience method that returns true if the user enabled dry-run mode
+func (s *Config) DryRun() bool {
+	return s.viper.GetBool(KeyDryRun)
+}
+
+// AssumeYes is a convenience method that returns true if the user confirmed operations beforehand with `--yes`
+func (s *Config) AssumeYes() bool {
+	return s.viper.GetBool(KeyYes)
+}
+
+// Profile is a convenience method that returns the name of the active profile, if any
+func (s *Config) Profile() string {
+	return s.viper.GetString(KeyProfile)
+}
+
+// Profiles returns the names of the profiles defined in the config file in alphabetical order
+func (s *Config) Profiles() []string {
+	profiles := make([]string, 0)
+	for name := range s.viper.GetStringMap(KeyProfiles) {
+		profiles = append(profiles, name)
+	}
+	slices.Sort(profiles)
+	return profiles
+}
+
+// ProfileSettings returns the settings of the named profile as defined in the config file
+func (s *Config) ProfileSettings(name string) (map[string]any, bool) {
+	key := KeyProfiles + "." + name
+	if !s.viper.IsSet(key) {
+		return nil, false
+	}
+	return s.viper.GetStringMap(key), true
+}
+
+// SetDefaultProfile sets the profile used by default by updating the profile key in the config file in use.
+// Other contents of the config file, including comments, are preserved.
+func (s *Config) SetDefaultProfile(name string) error {
+	path := s.viper.ConfigFileUsed()
+	if path == "" {
+		return fmt.Errorf("config file not found")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unable to read config file '%s': %w", path, err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read config file '%s': %w", path, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return fmt.Errorf("unable to parse config file '%s': %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("unable to update config file '%s': top-level value is not a mapping", path)
+	}
+
+	updated := false
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == KeyProfile {
+			root.Content[i+1].SetString(name)
+			updated = true
+		}
+	}
+	if !updated {
+		key := &yaml.Node{}
+		key.SetString(KeyProfile)
+		value := &yaml.Node{}
+		value.SetString(name)
+		root.Content = append(root.Content, key, value)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("unable to encode config file: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("unable to encode config file: %w", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), info.Mode().Perm()); err != nil {
+		return fmt.Errorf("unable to write config file '%s': %w", path, err)
+	}
+	s.viper.Set(KeyProfile, name)
+	return nil
 }
 
 // ClientTimeout is a convenience method that returns the user specified client timeout
@@ -259,8 +502,16 @@ func GetVersion() string {
 	return version
 }
 
-func SaveTokenToKeyring(token string) error {
-	return keyring.Set(credentials.KeyringServiceName, credentials.KeyringTokenUser, token)
+// SaveTokenToKeyring saves the token to the system keyring. If profile is not empty, the token is saved for the named profile.
+func SaveTokenToKeyring(profile, token string) error {
+	return keyring.Set(credentials.KeyringServiceName, keyringTokenUser(profile), token)
+}
+
+func keyringTokenUser(profile string) string {
+	if profile == "" {
+		return credentials.KeyringTokenUser
+	}
+	return keyringProfileUserPrefix + profile
 }
 
 func getVersion() string {
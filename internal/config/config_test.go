@@ -98,3 +98,111 @@ func TestConfig_LoadKeyring(t *testing.T) {
 		assert.NoError(t, keyring.Delete("UpCloud", "unittest"))
 	})
 }
+
+func TestConfig_LoadProfile(t *testing.T) {
+	t.Setenv("UPCLOUD_USERNAME", "")
+	t.Setenv("UPCLOUD_PASSWORD", "")
+	t.Setenv("UPCLOUD_TOKEN", "")
+	t.Setenv("UPCLOUD_ZONE", "")
+
+	tmpFile, err := os.CreateTemp(os.TempDir(), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, tmpFile.Close())
+		assert.NoError(t, os.Remove(tmpFile.Name()))
+	})
+	_, err = tmpFile.WriteString(`username: default_user
+password: default_password
+output: yaml
+profiles:
+  staging:
+    token: ucat_staging
+    zone: de-fra1
+  production:
+    username: production_user
+    password: production_password
+    output: json
+`)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name             string
+		profile          string
+		expectedUsername string
+		expectedToken    string
+		expectedZone     string
+		expectedOutput   string
+	}{
+		{
+			name:             "no profile",
+			expectedUsername: "default_user",
+			expectedOutput:   "yaml",
+		},
+		{
+			name:           "profile with token",
+			profile:        "staging",
+			expectedToken:  "ucat_staging",
+			expectedZone:   "de-fra1",
+			expectedOutput: "yaml",
+		},
+		{
+			name:             "profile with username and password",
+			profile:          "production",
+			expectedUsername: "production_user",
+			expectedOutput:   "json",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("UPCLOUD_PROFILE", test.profile)
+
+			cfg := New()
+			cfg.GlobalFlags.ConfigFile = tmpFile.Name()
+			err = cfg.Load()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.profile, cfg.Profile())
+			assert.Equal(t, test.expectedUsername, cfg.GetString("username"))
+			assert.Equal(t, test.expectedToken, cfg.GetString("token"))
+			assert.Equal(t, test.expectedZone, cfg.GetString(KeyZone))
+			assert.Equal(t, test.expectedOutput, cfg.Output())
+			assert.Equal(t, []string{"production", "staging"}, cfg.Profiles())
+		})
+	}
+}
+
+func TestConfig_SetDefaultProfile(t *testing.T) {
+	tmpFile, err := os.CreateTemp(os.TempDir(), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, tmpFile.Close())
+		assert.NoError(t, os.Remove(tmpFile.Name()))
+	})
+	_, err = tmpFile.WriteString(`# Default settings
+output: json
+profiles:
+  staging:
+    zone: de-fra1 # Frankfurt
+`)
+	require.NoError(t, err)
+
+	cfg := New()
+	cfg.GlobalFlags.ConfigFile = tmpFile.Name()
+	require.NoError(t, cfg.Load())
+
+	require.NoError(t, cfg.SetDefaultProfile("staging"))
+	content, err := os.ReadFile(tmpFile.Name())
+	require.NoError(t, err)
+	assert.Equal(t, `# Default settings
+output: json
+profiles:
+  staging:
+    zone: de-fra1 # Frankfurt
+profile: staging
+`, string(content))
+
+	require.NoError(t, cfg.SetDefaultProfile("production"))
+	content, err = os.ReadFile(tmpFile.Name())
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "profile: production\n")
+	assert.NotContains(t, string(content), "profile: staging")
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/base"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/terminal"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 
@@ -43,7 +45,7 @@ func BuildRootCmd(conf *config.Config) cobra.Command {
 			// Environment variable with US spelling to support https://no-color.org
 			case conf.GlobalFlags.NoColours == config.True || os.Getenv("NO_COLOR") != "":
 				text.DisableColors()
-			case conf.GlobalFlags.OutputFormat != config.ValueOutputHuman:
+			case conf.GlobalFlags.OutputFormat != config.ValueOutputHuman && conf.GlobalFlags.OutputFormat != config.ValueOutputWide:
 				text.DisableColors()
 			default:
 				if terminal.IsStdoutTerminal() {
@@ -58,10 +60,14 @@ func BuildRootCmd(conf *config.Config) cobra.Command {
 			}
 
 			// Validate viper output binding too
-			if conf.Output() != config.ValueOutputHuman &&
-				conf.Output() != config.ValueOutputJSON &&
-				conf.Output() != config.ValueOutputYAML {
-				return fmt.Errorf("output format '%v' not accepted", conf.Output())
+			if err := output.ValidateFormat(conf.Output()); err != nil {
+				return err
+			}
+			if _, err := filter.ParseAll(conf.Filters()); err != nil {
+				return err
+			}
+			if _, err := filter.ParseSelector(conf.Selector()); err != nil {
+				return err
 			}
 
 			return nil
@@ -72,10 +78,31 @@ func BuildRootCmd(conf *config.Config) cobra.Command {
 	flags.StringVarP(
 		&conf.GlobalFlags.ConfigFile, "config", "", "", "Configuration file path.",
 	)
-	outputFormats := []string{config.ValueOutputHuman, config.ValueOutputJSON, config.ValueOutputYAML}
+	outputFormats := []string{config.ValueOutputHuman, config.ValueOutputWide, config.ValueOutputJSON, config.ValueOutputYAML, config.ValueOutputCSV, config.ValueOutputTSV, config.ValueOutputMarkdown}
+	templateFormats := []string{"jsonpath=<expression>", "go-template=<template>", "template-file=<path>"}
 	flags.StringVarP(
 		&conf.GlobalFlags.OutputFormat, "output", "o", "human",
-		"Output format. Valid values are "+namedargs.ValidValuesHelp(outputFormats...)+".",
+		"Output format. Valid values are "+namedargs.ValidValuesHelp(append(outputFormats, templateFormats...)...)+". The template formats are evaluated against the same value that is printed with `json` output. The `csv`, `tsv`, and `markdown` formats are only supported for commands that output a table. The `wide` format is human output with additional columns.",
+	)
+	flags.StringSliceVar(
+		&conf.GlobalFlags.Columns, "columns", nil,
+		"Comma-separated list of column keys to include in table output, e.g., `uuid,hostname,zone`. Columns hidden by default can also be selected. The keys match the keys in `json` output of the table.",
+	)
+	flags.StringVar(
+		&conf.GlobalFlags.SortBy, "sort-by", "",
+		"Column key to sort the rows of table output by. Prefix the key with `-` to sort in descending order, e.g., `-created`.",
+	)
+	flags.StringArrayVar(
+		&conf.GlobalFlags.Filters, "filter", nil,
+		"Include only rows of table output where the column matches the given value, e.g., `zone=fi-hel1` or `state!=stopped`. The value can be a glob pattern, e.g., `name=*-dev`. If defined multiple times, rows must match all of the filters. The keys match the keys of `--columns`.",
+	)
+	flags.StringVarP(
+		&conf.GlobalFlags.Selector, "selector", "l", "",
+		"Include only resources with labels matching the given label selector, e.g., `env=prod,team in (a,b)`. Supported requirements are `key`, `!key`, `key=value`, `key!=value`, `key in (values)`, and `key notin (values)`. For commands that accept multiple resources as arguments, e.g., `server stop`, the selector targets the matching resources. The same can be achieved with `label:<selector>` argument, e.g., `label:env=prod`.",
+	)
+	flags.StringVar(
+		&conf.GlobalFlags.Profile, "profile", "",
+		"Name of the configuration profile to use. The profile can also be selected with UPCLOUD_PROFILE environment variable or with profile key in the configuration file.",
 	)
 	config.AddToggleFlag(flags, &conf.GlobalFlags.ForceColours, "force-colours", false, "Force coloured output despite detected terminal support.")
 	config.AddToggleFlag(flags, &conf.GlobalFlags.NoColours, "no-colours", false, "Disable coloured output despite detected terminal support. Colours can also be disabled by setting NO_COLOR environment variable.")
@@ -83,13 +110,20 @@ func BuildRootCmd(conf *config.Config) cobra.Command {
 		&conf.GlobalFlags.Debug, "debug", false,
 		"Print out more verbose debug logs.",
 	)
+	flags.BoolVar(
+		&conf.GlobalFlags.DryRun, "dry-run", false,
+		"Print the API calls that would modify resources instead of executing them. Read-only calls, e.g., for resolving resource names, are still executed.",
+	)
+	flags.BoolVarP(
+		&conf.GlobalFlags.AssumeYes, "yes", "y", false,
+		"Confirm destructive operations, e.g., deleting resources, and operations targeting more than 10 resources with a label selector without prompting. Required for these operations in non-interactive mode.",
+	)
 	flags.DurationVarP(
 		&conf.GlobalFlags.ClientTimeout, "client-timeout", "t",
 		0,
 		"Client timeout to use in API calls.",
 	)
 
-	// XXX: Apply viper value to the help as default
 	// Add flags
 	flags.VisitAll(func(flag *pflag.Flag) {
 		rootCmd.PersistentFlags().AddFlag(flag)
@@ -99,8 +133,18 @@ func BuildRootCmd(conf *config.Config) cobra.Command {
 	rootCmd.SetUsageTemplate(ui.CommandUsageTemplate())
 	rootCmd.SetUsageFunc(ui.UsageFunc)
 
-	commands.Must(rootCmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(outputFormats, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(rootCmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(append(outputFormats, "jsonpath=", "go-template=", "template-file="), cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveNoSpace)))
 	commands.Must(rootCmd.RegisterFlagCompletionFunc("client-timeout", cobra.NoFileCompletions))
+	commands.Must(rootCmd.RegisterFlagCompletionFunc("columns", cobra.NoFileCompletions))
+	commands.Must(rootCmd.RegisterFlagCompletionFunc("sort-by", cobra.NoFileCompletions))
+	commands.Must(rootCmd.RegisterFlagCompletionFunc("filter", cobra.NoFileCompletions))
+	commands.Must(rootCmd.RegisterFlagCompletionFunc("selector", cobra.NoFileCompletions))
+	commands.Must(rootCmd.RegisterFlagCompletionFunc("profile", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		if err := conf.Load(); err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return conf.Profiles(), cobra.ShellCompDirectiveNoFileComp
+	}))
 
 	return rootCmd
 }
@@ -31,7 +31,15 @@ func TestInputValidation(t *testing.T) {
 				"--output", "toml",
 				"version",
 			},
-			error: "OutputFormat: toml does not validate as in(human|json|yaml)",
+			error: "output format 'toml' not accepted",
+		},
+		{
+			name: "validate output template",
+			args: []string{
+				"--output", "jsonpath={.uuid",
+				"version",
+			},
+			error: "invalid jsonpath expression: unclosed action",
 		},
 		{
 			name: "validate config flag",
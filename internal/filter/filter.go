@@ -0,0 +1,80 @@
+package filter
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Operator defines how the value of a filter is compared to the value of the column.
+type Operator string
+
+const (
+	// OperatorEquals matches values that are equal to the filter value or match it as a glob pattern.
+	OperatorEquals Operator = "="
+	// OperatorNotEquals matches values that are not equal to the filter value and do not match it as a glob pattern.
+	OperatorNotEquals Operator = "!="
+)
+
+// Filter matches a single column of a table, e.g., `zone=fi-hel1` or `state!=stopped`.
+type Filter struct {
+	Key      string
+	Operator Operator
+	Value    string
+}
+
+// Parse parses a filter in `<key>=<value>`, `<key>==<value>`, or `<key>!=<value>` syntax.
+func Parse(in string) (Filter, error) {
+	// Split on the first operator, so that the value can contain operator characters, e.g., `name=a!=b`.
+	var f Filter
+	i := strings.Index(in, "=")
+	switch {
+	case i < 0:
+		return f, fmt.Errorf("invalid filter %q, expected <key>=<value> or <key>!=<value>", in)
+	case i > 0 && in[i-1] == '!':
+		f.Key, f.Operator, f.Value = in[:i-1], OperatorNotEquals, in[i+1:]
+	case strings.HasPrefix(in[i+1:], "="):
+		f.Key, f.Operator, f.Value = in[:i], OperatorEquals, in[i+2:]
+	default:
+		f.Key, f.Operator, f.Value = in[:i], OperatorEquals, in[i+1:]
+	}
+
+	f.Key = strings.TrimSpace(f.Key)
+	if f.Key == "" {
+		return f, fmt.Errorf("invalid filter %q, key is required", in)
+	}
+	if _, err := filepath.Match(f.Value, ""); err != nil {
+		return f, fmt.Errorf("invalid filter %q: %w", in, err)
+	}
+	return f, nil
+}
+
+// ParseAll parses multiple filters. The filters are combined with logical AND.
+func ParseAll(in []string) ([]Filter, error) {
+	filters := make([]Filter, 0, len(in))
+	for _, i := range in {
+		f, err := Parse(i)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+// Matches checks if the given value matches the filter. Filter values can be glob patterns, e.g., `fi-*`.
+func (f Filter) Matches(value string) bool {
+	matched := value == f.Value
+	if !matched {
+		matched, _ = filepath.Match(f.Value, value)
+	}
+
+	if f.Operator == OperatorNotEquals {
+		return !matched
+	}
+	return matched
+}
+
+func (f Filter) String() string {
+	return f.Key + string(f.Operator) + f.Value
+}
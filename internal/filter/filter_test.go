@@ -0,0 +1,57 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		in       string
+		expected Filter
+		err      string
+	}{
+		{in: "zone=fi-hel1", expected: Filter{Key: "zone", Operator: OperatorEquals, Value: "fi-hel1"}},
+		{in: "zone==fi-hel1", expected: Filter{Key: "zone", Operator: OperatorEquals, Value: "fi-hel1"}},
+		{in: "state!=stopped", expected: Filter{Key: "state", Operator: OperatorNotEquals, Value: "stopped"}},
+		{in: "name=", expected: Filter{Key: "name", Operator: OperatorEquals, Value: ""}},
+		{in: "name=a!=b", expected: Filter{Key: "name", Operator: OperatorEquals, Value: "a!=b"}},
+		{in: "name!=a==b", expected: Filter{Key: "name", Operator: OperatorNotEquals, Value: "a==b"}},
+		{in: "zone", err: `invalid filter "zone", expected <key>=<value> or <key>!=<value>`},
+		{in: "=fi-hel1", err: `invalid filter "=fi-hel1", key is required`},
+		{in: "name=[", err: `invalid filter "name=[": syntax error in pattern`},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			f, err := Parse(test.in)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, f)
+		})
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	for _, test := range []struct {
+		filter   string
+		value    string
+		expected bool
+	}{
+		{filter: "zone=fi-hel1", value: "fi-hel1", expected: true},
+		{filter: "zone=fi-hel1", value: "fi-hel2", expected: false},
+		{filter: "zone=fi-*", value: "fi-hel2", expected: true},
+		{filter: "zone!=fi-*", value: "fi-hel2", expected: false},
+		{filter: "state!=stopped", value: "started", expected: true},
+		{filter: "title=", value: "", expected: true},
+	} {
+		t.Run(test.filter+" "+test.value, func(t *testing.T) {
+			f, err := Parse(test.filter)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, f.Matches(test.value))
+		})
+	}
+}
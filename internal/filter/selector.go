@@ -0,0 +1,141 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type selectorOperator string
+
+const (
+	selectorExists    selectorOperator = "exists"
+	selectorNotExists selectorOperator = "!"
+	selectorEquals    selectorOperator = "="
+	selectorNotEquals selectorOperator = "!="
+	selectorIn        selectorOperator = "in"
+	selectorNotIn     selectorOperator = "notin"
+)
+
+// Requirement is a single requirement of a label selector, e.g., `env=prod` or `team in (a,b)`.
+type Requirement struct {
+	Key      string
+	operator selectorOperator
+	values   []string
+}
+
+// Selector matches resources by their labels. All requirements of the selector must match.
+type Selector []Requirement
+
+var setRequirementRe = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// ParseSelector parses a label selector. Requirements are separated by commas and can be defined as `key`, `!key`, `key=value`, `key!=value`, `key in (value1,value2)`, or `key notin (value1,value2)`.
+func ParseSelector(in string) (Selector, error) {
+	parts, err := splitRequirements(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var selector Selector
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		r, err := parseRequirement(part)
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, r)
+	}
+	return selector, nil
+}
+
+// splitRequirements splits the selector by commas that are not inside parentheses.
+func splitRequirements(in string) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range in {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("invalid selector %q, nested parentheses are not supported", in)
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("invalid selector %q, unexpected )", in)
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, in[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid selector %q, missing )", in)
+	}
+	return append(parts, in[start:]), nil
+}
+
+func parseRequirement(in string) (Requirement, error) {
+	if m := setRequirementRe.FindStringSubmatch(in); m != nil {
+		var values []string
+		for _, value := range strings.Split(m[3], ",") {
+			values = append(values, strings.TrimSpace(value))
+		}
+		return Requirement{Key: m[1], operator: selectorOperator(m[2]), values: values}, nil
+	}
+
+	var r Requirement
+	switch {
+	case strings.HasPrefix(in, "!"):
+		r = Requirement{Key: strings.TrimSpace(in[1:]), operator: selectorNotExists}
+	case strings.Contains(in, "!="):
+		key, value, _ := strings.Cut(in, "!=")
+		r = Requirement{Key: strings.TrimSpace(key), operator: selectorNotEquals, values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(in, "=="):
+		key, value, _ := strings.Cut(in, "==")
+		r = Requirement{Key: strings.TrimSpace(key), operator: selectorEquals, values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(in, "="):
+		key, value, _ := strings.Cut(in, "=")
+		r = Requirement{Key: strings.TrimSpace(key), operator: selectorEquals, values: []string{strings.TrimSpace(value)}}
+	default:
+		r = Requirement{Key: in, operator: selectorExists}
+	}
+
+	if r.Key == "" || strings.ContainsAny(r.Key, " \t()") {
+		return r, fmt.Errorf("invalid selector requirement %q", in)
+	}
+	return r, nil
+}
+
+// Matches checks if the given labels match all requirements of the selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches checks if the given labels match the requirement.
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, exists := labels[r.Key]
+	switch r.operator {
+	case selectorExists:
+		return exists
+	case selectorNotExists:
+		return !exists
+	case selectorEquals, selectorIn:
+		return exists && slices.Contains(r.values, value)
+	case selectorNotEquals, selectorNotIn:
+		return !exists || !slices.Contains(r.values, value)
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSelector_Errors(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		err string
+	}{
+		{in: "team in (a,b", err: `invalid selector "team in (a,b", missing )`},
+		{in: "team in a,b)", err: `invalid selector "team in a,b)", unexpected )`},
+		{in: "team in ((a))", err: `invalid selector "team in ((a))", nested parentheses are not supported`},
+		{in: "env=prod,=dev", err: `invalid selector requirement "=dev"`},
+		{in: "env prod", err: `invalid selector requirement "env prod"`},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			_, err := ParseSelector(test.in)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestSelector_Matches(t *testing.T) {
+	labels := map[string]string{
+		"env":  "prod",
+		"team": "a",
+	}
+
+	for _, test := range []struct {
+		selector string
+		expected bool
+	}{
+		{selector: "", expected: true},
+		{selector: "env", expected: true},
+		{selector: "!env", expected: false},
+		{selector: "!owner", expected: true},
+		{selector: "env=prod", expected: true},
+		{selector: "env==prod", expected: true},
+		{selector: "env=dev", expected: false},
+		{selector: "env!=dev", expected: true},
+		{selector: "owner!=me", expected: true},
+		{selector: "env=prod,team in (a,b)", expected: true},
+		{selector: "env=prod, team in (b, c)", expected: false},
+		{selector: "team notin (b,c)", expected: true},
+		{selector: "owner notin (me)", expected: true},
+		{selector: "owner in (me)", expected: false},
+	} {
+		t.Run(test.selector, func(t *testing.T) {
+			s, err := ParseSelector(test.selector)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, s.Matches(labels))
+		})
+	}
+}
@@ -2,14 +2,19 @@ package format
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/jedib0t/go-pretty/v6/text"
 )
 
-// Boolean returns val formatted as a boolean
+// Boolean returns val formatted as a boolean. Nil boolean pointers are formatted as false.
 func Boolean(val any) (text.Colors, string, error) {
+	if ptr, ok := val.(*bool); ok {
+		val = ptr != nil && *ptr
+	}
+
 	if vb, ok := val.(bool); ok {
 		if vb {
 			return ui.DefaultBooleanColoursTrue, "yes", nil
@@ -27,6 +32,16 @@ func Boolean(val any) (text.Colors, string, error) {
 	return nil, "", fmt.Errorf("cannot parse '%v' (%T) as boolean", val, val)
 }
 
+// Duration returns val formatted as a duration, e.g. 1h2m5s.
+func Duration(val any) (text.Colors, string, error) {
+	d, ok := val.(time.Duration)
+	if !ok {
+		return nil, "", fmt.Errorf("cannot parse %T, expected time.Duration", val)
+	}
+
+	return nil, d.String(), nil
+}
+
 // Dereference returns "%v" Sprintf'ed value of a pointer
 func Dereference[T any](val any) (text.Colors, string, error) {
 	ptr, ok := val.(*T)
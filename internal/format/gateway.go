@@ -0,0 +1,23 @@
+package format
+
+import (
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// gatewayOperationalStateColour maps gateway states to colours
+func gatewayOperationalStateColour(state upcloud.GatewayOperationalState) text.Colors {
+	switch state {
+	case upcloud.GatewayOperationalStateRunning:
+		return text.Colors{text.FgGreen}
+	case upcloud.GatewayOperationalStatePending, upcloud.GatewayOperationalStateSetupAgent, upcloud.GatewayOperationalStateSetupLinkNetwork, upcloud.GatewayOperationalStateSetupServer, upcloud.GatewayOperationalStateSetupNetwork, upcloud.GatewayOperationalStateSetupGW, upcloud.GatewayOperationalStateSetupDNS, upcloud.GatewayOperationalStateCheckup:
+		return text.Colors{text.FgYellow}
+	default:
+		return text.Colors{text.FgHiBlack}
+	}
+}
+
+// GatewayState implements Format function for gateway states
+func GatewayState(val any) (text.Colors, string, error) {
+	return usingColorFunction(gatewayOperationalStateColour, val)
+}
@@ -0,0 +1,39 @@
+package labels
+
+import (
+	"context"
+
+	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"golang.org/x/sync/errgroup"
+)
+
+// fetchConcurrency limits the number of concurrent requests when fetching labels that are not included in list responses.
+const fetchConcurrency = 8
+
+// ServerLabels fetches the labels of the given servers from server details and returns them by server UUID. Server list does not include labels.
+func ServerLabels(ctx context.Context, svc internal.AllServices, servers []upcloud.Server) (map[string][]upcloud.Label, error) {
+	labels := make([][]upcloud.Label, len(servers))
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(fetchConcurrency)
+	for i, server := range servers {
+		eg.Go(func() error {
+			details, err := svc.GetServerDetails(ctx, &request.GetServerDetailsRequest{UUID: server.UUID})
+			if err != nil {
+				return err
+			}
+			labels[i] = details.Labels
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	labelsByUUID := make(map[string][]upcloud.Label, len(servers))
+	for i, server := range servers {
+		labelsByUUID[server.UUID] = labels[i]
+	}
+	return labelsByUUID, nil
+}
@@ -2,6 +2,7 @@ package labels
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
@@ -32,3 +33,26 @@ func GetLabelsSectionWithResourceType(labels []upcloud.Label, resourceType strin
 func GetLabelsSection(labels []upcloud.Label) output.CombinedSection {
 	return GetLabelsSectionWithResourceType(labels, "resource")
 }
+
+// Cell is a table cell value for resource labels. It is rendered as a comma separated list of `key=value` pairs and it allows matching table rows with label selectors.
+type Cell []upcloud.Label
+
+var _ output.LabelsCell = Cell{}
+
+// String implements fmt.Stringer
+func (c Cell) String() string {
+	strs := make([]string, len(c))
+	for i, label := range c {
+		strs[i] = fmt.Sprintf("%s=%s", label.Key, label.Value)
+	}
+	return strings.Join(strs, ", ")
+}
+
+// LabelMap implements output.LabelsCell
+func (c Cell) LabelMap() map[string]string {
+	labels := make(map[string]string, len(c))
+	for _, label := range c {
+		labels[label.Key] = label.Value
+	}
+	return labels
+}
@@ -18,17 +18,23 @@ type Service struct {
 
 // AttachLoadBalancerIPAddress implements service.LoadBalancer.
 func (m *Service) AttachLoadBalancerIPAddress(ctx context.Context, r *request.AttachLoadBalancerIPAddressRequest) (upcloud.LoadBalancerFloatingIPAddress, error) {
-	return upcloud.LoadBalancerFloatingIPAddress{}, nil
+	args := m.Called(r)
+	return args[0].(upcloud.LoadBalancerFloatingIPAddress), args.Error(1)
 }
 
 // GetLoadBalancerIPAddresses implements service.LoadBalancer.
 func (m *Service) GetLoadBalancerIPAddresses(ctx context.Context, r *request.GetLoadBalancerIPAddressesRequest) ([]upcloud.LoadBalancerFloatingIPAddress, error) {
-	return []upcloud.LoadBalancerFloatingIPAddress{}, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerFloatingIPAddress), args.Error(1)
 }
 
 // RemoveLoadBalancerIPAddress implements service.LoadBalancer.
 func (m *Service) RemoveLoadBalancerIPAddress(ctx context.Context, r *request.RemoveLoadBalancerIPAddressRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 // GetAllManagedDatabases implements service.AllServices (or the interface that declares it).
@@ -94,7 +100,11 @@ func (m *Service) GetPriceZones(context.Context) (*upcloud.PriceZones, error) {
 
 // GetPricingByZone implements service.Zones.GetPricingByZone
 func (m *Service) GetPricesByZone(context.Context) (*upcloud.PricesByZone, error) {
-	return nil, nil
+	args := m.Called()
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.PricesByZone), args.Error(1)
 }
 
 // GetTimeZones implements service.Zones.GetPriceZones
@@ -655,7 +665,11 @@ func (m *Service) CancelManagedDatabaseSession(_ context.Context, r *request.Can
 }
 
 func (m *Service) CloneManagedDatabase(_ context.Context, r *request.CloneManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabase), args.Error(1)
 }
 
 func (m *Service) CreateManagedDatabase(_ context.Context, r *request.CreateManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
@@ -691,7 +705,11 @@ func (m *Service) GetManagedDatabases(_ context.Context, r *request.GetManagedDa
 }
 
 func (m *Service) GetManagedDatabaseAccessControl(_ context.Context, r *request.GetManagedDatabaseAccessControlRequest) (*upcloud.ManagedDatabaseAccessControl, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseAccessControl), args.Error(1)
 }
 
 func (m *Service) GetManagedDatabaseSessions(_ context.Context, r *request.GetManagedDatabaseSessionsRequest) (upcloud.ManagedDatabaseSessions, error) {
@@ -707,19 +725,35 @@ func (m *Service) GetManagedDatabaseIndices(_ context.Context, r *request.GetMan
 }
 
 func (m *Service) GetManagedDatabaseMetrics(_ context.Context, r *request.GetManagedDatabaseMetricsRequest) (*upcloud.ManagedDatabaseMetrics, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseMetrics), args.Error(1)
 }
 
 func (m *Service) GetManagedDatabaseLogs(_ context.Context, r *request.GetManagedDatabaseLogsRequest) (*upcloud.ManagedDatabaseLogs, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseLogs), args.Error(1)
 }
 
 func (m *Service) GetManagedDatabaseQueryStatisticsMySQL(_ context.Context, r *request.GetManagedDatabaseQueryStatisticsRequest) ([]upcloud.ManagedDatabaseQueryStatisticsMySQL, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.ManagedDatabaseQueryStatisticsMySQL), args.Error(1)
 }
 
 func (m *Service) GetManagedDatabaseQueryStatisticsPostgreSQL(_ context.Context, r *request.GetManagedDatabaseQueryStatisticsRequest) ([]upcloud.ManagedDatabaseQueryStatisticsPostgreSQL, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.ManagedDatabaseQueryStatisticsPostgreSQL), args.Error(1)
 }
 
 func (m *Service) GetManagedDatabaseServiceType(_ context.Context, r *request.GetManagedDatabaseServiceTypeRequest) (*upcloud.ManagedDatabaseType, error) {
@@ -744,19 +778,35 @@ func (m *Service) DeleteManagedDatabase(_ context.Context, r *request.DeleteMana
 }
 
 func (m *Service) ModifyManagedDatabase(_ context.Context, r *request.ModifyManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabase), args.Error(1)
 }
 
 func (m *Service) ModifyManagedDatabaseAccessControl(_ context.Context, r *request.ModifyManagedDatabaseAccessControlRequest) (*upcloud.ManagedDatabaseAccessControl, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseAccessControl), args.Error(1)
 }
 
 func (m *Service) UpgradeManagedDatabaseVersion(_ context.Context, r *request.UpgradeManagedDatabaseVersionRequest) (*upcloud.ManagedDatabase, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabase), args.Error(1)
 }
 
 func (m *Service) GetManagedDatabaseVersions(_ context.Context, r *request.GetManagedDatabaseVersionsRequest) ([]string, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]string), args.Error(1)
 }
 
 func (m *Service) StartManagedDatabase(_ context.Context, r *request.StartManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
@@ -776,7 +826,11 @@ func (m *Service) ShutdownManagedDatabase(_ context.Context, r *request.Shutdown
 }
 
 func (m *Service) WaitForManagedDatabaseState(_ context.Context, r *request.WaitForManagedDatabaseStateRequest) (*upcloud.ManagedDatabase, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabase), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancers(_ context.Context, r *request.GetLoadBalancersRequest) ([]upcloud.LoadBalancer, error) {
@@ -788,15 +842,27 @@ func (m *Service) GetLoadBalancers(_ context.Context, r *request.GetLoadBalancer
 }
 
 func (m *Service) GetLoadBalancer(_ context.Context, r *request.GetLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancer), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancer(_ context.Context, r *request.CreateLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancer), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancer(_ context.Context, r *request.ModifyLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancer), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancer(_ context.Context, r *request.DeleteLoadBalancerRequest) error {
@@ -805,83 +871,151 @@ func (m *Service) DeleteLoadBalancer(_ context.Context, r *request.DeleteLoadBal
 }
 
 func (m *Service) GetLoadBalancerBackends(_ context.Context, r *request.GetLoadBalancerBackendsRequest) ([]upcloud.LoadBalancerBackend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerBackend), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerBackend(_ context.Context, r *request.GetLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackend), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerBackend(_ context.Context, r *request.CreateLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackend), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerBackend(_ context.Context, r *request.ModifyLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackend), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerBackend(_ context.Context, r *request.DeleteLoadBalancerBackendRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerBackendMembers(_ context.Context, r *request.GetLoadBalancerBackendMembersRequest) ([]upcloud.LoadBalancerBackendMember, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerBackendMember), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerBackendMember(_ context.Context, r *request.GetLoadBalancerBackendMemberRequest) (*upcloud.LoadBalancerBackendMember, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendMember), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerBackendMember(_ context.Context, r *request.CreateLoadBalancerBackendMemberRequest) (*upcloud.LoadBalancerBackendMember, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendMember), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerBackendMember(_ context.Context, r *request.ModifyLoadBalancerBackendMemberRequest) (*upcloud.LoadBalancerBackendMember, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendMember), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerBackendMember(_ context.Context, r *request.DeleteLoadBalancerBackendMemberRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerBackendTLSConfigs(_ context.Context, r *request.GetLoadBalancerBackendTLSConfigsRequest) ([]upcloud.LoadBalancerBackendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerBackendTLSConfig), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerBackendTLSConfig(_ context.Context, r *request.GetLoadBalancerBackendTLSConfigRequest) (*upcloud.LoadBalancerBackendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendTLSConfig), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerBackendTLSConfig(_ context.Context, r *request.CreateLoadBalancerBackendTLSConfigRequest) (*upcloud.LoadBalancerBackendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendTLSConfig), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerBackendTLSConfig(_ context.Context, r *request.ModifyLoadBalancerBackendTLSConfigRequest) (*upcloud.LoadBalancerBackendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendTLSConfig), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerBackendTLSConfig(_ context.Context, r *request.DeleteLoadBalancerBackendTLSConfigRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerResolvers(_ context.Context, r *request.GetLoadBalancerResolversRequest) ([]upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerResolver(_ context.Context, r *request.CreateLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerResolver(_ context.Context, r *request.GetLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerResolver(_ context.Context, r *request.ModifyLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerResolver(_ context.Context, r *request.DeleteLoadBalancerResolverRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerPlans(_ context.Context, r *request.GetLoadBalancerPlansRequest) ([]upcloud.LoadBalancerPlan, error) {
@@ -889,91 +1023,167 @@ func (m *Service) GetLoadBalancerPlans(_ context.Context, r *request.GetLoadBala
 }
 
 func (m *Service) GetLoadBalancerFrontends(_ context.Context, r *request.GetLoadBalancerFrontendsRequest) ([]upcloud.LoadBalancerFrontend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerFrontend), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerFrontend(_ context.Context, r *request.GetLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontend), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerFrontend(_ context.Context, r *request.CreateLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontend), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerFrontend(_ context.Context, r *request.ModifyLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontend), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerFrontend(_ context.Context, r *request.DeleteLoadBalancerFrontendRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerFrontendRules(_ context.Context, r *request.GetLoadBalancerFrontendRulesRequest) ([]upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerFrontendRule(_ context.Context, r *request.GetLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerFrontendRule(_ context.Context, r *request.CreateLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerFrontendRule(_ context.Context, r *request.ModifyLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) ReplaceLoadBalancerFrontendRule(_ context.Context, r *request.ReplaceLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerFrontendRule(_ context.Context, r *request.DeleteLoadBalancerFrontendRuleRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerFrontendTLSConfigs(_ context.Context, r *request.GetLoadBalancerFrontendTLSConfigsRequest) ([]upcloud.LoadBalancerFrontendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerFrontendTLSConfig), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerFrontendTLSConfig(_ context.Context, r *request.GetLoadBalancerFrontendTLSConfigRequest) (*upcloud.LoadBalancerFrontendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendTLSConfig), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerFrontendTLSConfig(_ context.Context, r *request.CreateLoadBalancerFrontendTLSConfigRequest) (*upcloud.LoadBalancerFrontendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendTLSConfig), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerFrontendTLSConfig(_ context.Context, r *request.ModifyLoadBalancerFrontendTLSConfigRequest) (*upcloud.LoadBalancerFrontendTLSConfig, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendTLSConfig), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerFrontendTLSConfig(_ context.Context, r *request.DeleteLoadBalancerFrontendTLSConfigRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerCertificateBundles(_ context.Context, r *request.GetLoadBalancerCertificateBundlesRequest) ([]upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerCertificateBundle(_ context.Context, r *request.GetLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerCertificateBundle(_ context.Context, r *request.CreateLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerCertificateBundle(_ context.Context, r *request.ModifyLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerCertificateBundle(_ context.Context, r *request.DeleteLoadBalancerCertificateBundleRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) ModifyLoadBalancerNetwork(ctx context.Context, r *request.ModifyLoadBalancerNetworkRequest) (*upcloud.LoadBalancerNetwork, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerNetwork), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerDNSChallengeDomain(ctx context.Context, r *request.GetLoadBalancerDNSChallengeDomainRequest) (*upcloud.LoadBalancerDNSChallengeDomain, error) {
@@ -1436,43 +1646,77 @@ func (m *Service) GetGatewayPlans(ctx context.Context) ([]upcloud.GatewayPlan, e
 }
 
 func (m *Service) GetGatewayMetrics(ctx context.Context, r *request.GetGatewayMetricsRequest) (*upcloud.GatewayMetrics, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayMetrics), args.Error(1)
 }
 
 func (m *Service) GetGatewayConnections(ctx context.Context, r *request.GetGatewayConnectionsRequest) ([]upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) GetGatewayConnection(ctx context.Context, r *request.GetGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) CreateGatewayConnection(ctx context.Context, r *request.CreateGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) ModifyGatewayConnection(ctx context.Context, r *request.ModifyGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) DeleteGatewayConnection(ctx context.Context, r *request.DeleteGatewayConnectionRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetGatewayConnectionTunnels(ctx context.Context, r *request.GetGatewayConnectionTunnelsRequest) ([]upcloud.GatewayTunnel, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.GatewayTunnel), args.Error(1)
 }
 
 func (m *Service) GetGatewayConnectionTunnel(ctx context.Context, r *request.GetGatewayConnectionTunnelRequest) (*upcloud.GatewayTunnel, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayTunnel), args.Error(1)
 }
 
 func (m *Service) CreateGatewayConnectionTunnel(ctx context.Context, r *request.CreateGatewayConnectionTunnelRequest) (*upcloud.GatewayTunnel, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayTunnel), args.Error(1)
 }
 
 func (m *Service) DeleteGatewayConnectionTunnel(ctx context.Context, r *request.DeleteGatewayConnectionTunnelRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetNetworkPeerings(ctx context.Context, f ...request.QueryFilter) (upcloud.NetworkPeerings, error) {
@@ -1545,7 +1789,7 @@ func (m *Service) ModifyTag(ctx context.Context, r *request.ModifyTagRequest) (*
 }
 
 func (m *Service) DeleteTag(ctx context.Context, r *request.DeleteTagRequest) error {
-	return nil
+	return m.Called(r).Error(0)
 }
 
 func (m *Service) TagServer(ctx context.Context, r *request.TagServerRequest) (*upcloud.ServerDetails, error) {
@@ -2,7 +2,9 @@ package mockexecute
 
 import (
 	"bytes"
+	"errors"
 
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
@@ -21,7 +23,8 @@ func MockExecute(command commands.Command, service service.AllServices, conf *co
 		conf.Viper().Set(config.KeyOutput, config.ValueOutputHuman)
 	}
 
-	command.Cobra().RunE = func(_ *cobra.Command, args []string) error {
+	command.Cobra().RunE = func(cmd *cobra.Command, args []string) error {
+		commands.MarkConfigDefaultsChanged(cmd)
 		return mockRunE(command, service, conf, args)
 	}
 	err := command.Cobra().Execute()
@@ -44,8 +47,13 @@ func mockRunE(command commands.Command, service service.AllServices, conf *confi
 		// Panics if called with nil args
 		out, err = typedCommand.Execute(executor, args[0])
 	}
-	if err != nil {
+	// Output of a failed check is rendered before returning the error, as in commands.commandRunE
+	var checkErr clierrors.CheckFailedError
+	if err != nil && (out == nil || !errors.As(err, &checkErr)) {
 		return err
 	}
-	return output.Render(command.Cobra().OutOrStdout(), conf.Output(), out)
+	if renderErr := commands.RenderOutput(command.Cobra().OutOrStdout(), conf, out); renderErr != nil {
+		return renderErr
+	}
+	return err
 }
@@ -1,13 +1,20 @@
 package namedargs
 
 import (
+	"context"
+
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
 	"github.com/spf13/cobra"
 )
 
 type CompleteFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
 
+// ValuesFunc lists values, e.g. names of sub-resources, of the resource with given UUID for completion.
+type ValuesFunc func(ctx context.Context, svc service.AllServices, uuid string) ([]string, error)
+
 // CompletionFunc creates a flag completion function from given completion provider and config to be passed to Cobra via Command.RegisterFlagCompletionFunc
 func CompletionFunc(provider completion.Provider, cfg *config.Config) CompleteFunc {
 	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -19,3 +26,33 @@ func CompletionFunc(provider completion.Provider, cfg *config.Config) CompleteFu
 		return provider.CompleteArgument(cfg.Context(), svc, toComplete)
 	}
 }
+
+// ResolvedArgumentCompletionFunc creates a flag completion function that completes values of the resource given as the first positional argument. The argument is resolved with given resolution provider and the values are listed with given function.
+func ResolvedArgumentCompletionFunc(provider resolver.ResolutionProvider, cfg *config.Config, values ValuesFunc) CompleteFunc {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return completion.None(toComplete)
+		}
+
+		svc, err := cfg.CreateService()
+		if err != nil {
+			return completion.None(toComplete)
+		}
+
+		argResolver, err := provider.Get(cfg.Context(), svc)
+		if err != nil {
+			return completion.None(toComplete)
+		}
+		resolved := argResolver(args[0])
+		uuid, err := resolved.GetOnly()
+		if err != nil {
+			return completion.None(toComplete)
+		}
+
+		vals, err := values(cfg.Context(), svc, uuid)
+		if err != nil {
+			return completion.None(toComplete)
+		}
+		return completion.MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+	}
+}
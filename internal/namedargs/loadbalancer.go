@@ -0,0 +1,18 @@
+package namedargs
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+)
+
+// ResolveCertificateBundle resolves load balancer certificate bundle UUID from values provided to named args (e.g., --certificate-bundle bundle-name)
+func ResolveCertificateBundle(exec commands.Executor, arg string) (string, error) {
+	cb, err := Resolve(&resolver.CachingCertificateBundle{}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve certificate bundle: %w", err)
+	}
+
+	return cb, err
+}
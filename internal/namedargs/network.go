@@ -16,3 +16,13 @@ func ResolveNetwork(exec commands.Executor, arg string) (string, error) {
 
 	return net, err
 }
+
+// ResolveRouter resolves router UUID from values provided to named args (e.g., --router router-name)
+func ResolveRouter(exec commands.Executor, arg string) (string, error) {
+	router, err := Resolve(&resolver.CachingRouter{}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve router: %w", err)
+	}
+
+	return router, err
+}
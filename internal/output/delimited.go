@@ -0,0 +1,200 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// tableOutputs returns the tables from the command outputs. Formats that are only defined for tables, e.g., csv, use this to fail with a clear error on non-table outputs.
+func tableOutputs(outputFormat string, commandOutputs ...Output) ([]Table, error) {
+	var tables []Table
+	for _, commandOutput := range commandOutputs {
+		switch o := commandOutput.(type) {
+		case Table:
+			tables = append(tables, o)
+		case MarshaledWithHumanOutput:
+			if t, ok := o.Output.(Table); ok {
+				tables = append(tables, t)
+				continue
+			}
+			return nil, fmt.Errorf("output format %s is only supported for commands that output a table, use json or yaml output instead", outputFormat)
+		case None, Error, Failure:
+			// Errors are printed to stderr and affect the exit code, they are not part of the table.
+			continue
+		default:
+			return nil, fmt.Errorf("output format %s is only supported for commands that output a table, use json or yaml output instead", outputFormat)
+		}
+	}
+
+	for i := 1; i < len(tables); i++ {
+		if !slices.Equal(tables[i].columnKeys(), tables[0].columnKeys()) {
+			return nil, fmt.Errorf("output format %s is not supported for outputs with multiple tables with different columns", outputFormat)
+		}
+	}
+	return tables, nil
+}
+
+func (s Table) columnKeys() []string {
+	keys := make([]string, len(s.Columns))
+	for i, column := range s.Columns {
+		keys[i] = column.Key
+	}
+	return keys
+}
+
+// rawRows returns the rows of the tables as unformatted strings. Hidden columns are omitted.
+func rawRows(tables []Table) (header []TableColumn, rows [][]string) {
+	if len(tables) == 0 {
+		return nil, nil
+	}
+
+	for _, column := range tables[0].Columns {
+		if !column.Hidden {
+			header = append(header, column)
+		}
+	}
+
+	for _, t := range tables {
+		for _, row := range t.Rows {
+			var cells []string
+			for i, column := range t.Columns {
+				if column.Hidden || i >= len(row) {
+					continue
+				}
+				cells = append(cells, rawCellValue(row[i]))
+			}
+			rows = append(rows, cells)
+		}
+	}
+	return header, rows
+}
+
+// rawCellValue converts a table cell value to string without the formatting used in human output.
+func rawCellValue(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	case []string:
+		return strings.Join(v, ", ")
+	}
+
+	// Marshal through a pointer so that values with pointer receiver MarshalJSON, e.g., upcloud.Boolean, are marshaled correctly.
+	ptr := reflect.New(reflect.TypeOf(val))
+	ptr.Elem().Set(reflect.ValueOf(val))
+	b, err := json.Marshal(ptr.Interface())
+	if err != nil {
+		return fmt.Sprintf("%v", val)
+	}
+
+	var str string
+	if err := json.Unmarshal(b, &str); err == nil {
+		return str
+	}
+	if string(b) == "null" {
+		return ""
+	}
+	return string(b)
+}
+
+func toDelimited(outputFormat string, delimiter rune, commandOutputs ...Output) ([]byte, error) {
+	tables, err := tableOutputs(outputFormat, commandOutputs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return []byte{}, nil
+	}
+	header, rows := rawRows(tables)
+
+	buffer := new(bytes.Buffer)
+	w := csv.NewWriter(buffer)
+	w.Comma = delimiter
+
+	keys := make([]string, len(header))
+	for i, column := range header {
+		keys[i] = column.Key
+	}
+	if err := w.Write(keys); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(escapeRows(rows, delimiter)); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// escapeRows escapes tabs and newlines in TSV output as TSV does not support quoting. For CSV, the values are quoted by the CSV writer.
+func escapeRows(rows [][]string, delimiter rune) [][]string {
+	if delimiter != '\t' {
+		return rows
+	}
+
+	replacer := strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r")
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = replacer.Replace(cell)
+		}
+	}
+	return rows
+}
+
+func toCSV(commandOutputs ...Output) ([]byte, error) {
+	return toDelimited(formatCSV, ',', commandOutputs...)
+}
+
+func toTSV(commandOutputs ...Output) ([]byte, error) {
+	return toDelimited(formatTSV, '\t', commandOutputs...)
+}
+
+func toMarkdown(commandOutputs ...Output) ([]byte, error) {
+	tables, err := tableOutputs(formatMarkdown, commandOutputs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return []byte{}, nil
+	}
+	header, rows := rawRows(tables)
+
+	replacer := strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>")
+	writeRow := func(buffer *bytes.Buffer, cells []string) {
+		buffer.WriteString("|")
+		for _, cell := range cells {
+			buffer.WriteString(" " + replacer.Replace(cell) + " |")
+		}
+		buffer.WriteString("\n")
+	}
+
+	headers := make([]string, len(header))
+	separators := make([]string, len(header))
+	for i, column := range header {
+		headers[i] = column.Header
+		if headers[i] == "" {
+			headers[i] = column.Key
+		}
+		separators[i] = "---"
+	}
+
+	buffer := new(bytes.Buffer)
+	writeRow(buffer, headers)
+	writeRow(buffer, separators)
+	for _, row := range rows {
+		writeRow(buffer, row)
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,21 @@
+package output
+
+// Failure is used when the command produced output even though it failed, e.g., because a check did not pass. Failure itself is not rendered, but Render returns its error after rendering the other outputs, so that it only affects the exit code.
+type Failure struct {
+	Value error
+}
+
+// MarshalJSON implements output.Output
+func (f Failure) MarshalJSON() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// MarshalHuman implements output.Output
+func (f Failure) MarshalHuman() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// MarshalRawMap implements output.Output
+func (f Failure) MarshalRawMap() (map[string]any, error) {
+	return map[string]any{}, nil
+}
@@ -12,18 +12,39 @@ import (
 
 const (
 	formatHuman string = "human"
+	formatWide  string = "wide"
 	formatJSON  string = "json"
 	formatYAML  string = "yaml"
+
+	formatCSV      string = "csv"
+	formatTSV      string = "tsv"
+	formatMarkdown string = "markdown"
+
+	formatJSONPath     string = "jsonpath"
+	formatGoTemplate   string = "go-template"
+	formatTemplateFile string = "template-file"
 )
 
 func formats() []string {
 	return []string{
 		formatHuman,
+		formatWide,
 		formatJSON,
 		formatYAML,
+		formatCSV,
+		formatTSV,
+		formatMarkdown,
+		formatJSONPath + "=<expression>",
+		formatGoTemplate + "=<template>",
+		formatTemplateFile + "=<path>",
 	}
 }
 
+// RendersValue checks if the output format renders the marshaled value of the outputs instead of their human-readable output.
+func RendersValue(outputFormat string) bool {
+	return outputFormat == formatJSON || outputFormat == formatYAML || isTemplateFormat(outputFormat)
+}
+
 // Render renders commandOutput to writer using cfg to configure the output.
 func Render(writer io.Writer, outputFormat string, commandOutputs ...Output) (err error) {
 	var b []byte
@@ -32,13 +53,27 @@ func Render(writer io.Writer, outputFormat string, commandOutputs ...Output) (er
 		if b, err = toHuman(commandOutputs...); err == nil && len(b) != 0 {
 			b = append(b, '\n')
 		}
+	case formatWide:
+		if b, err = toHuman(showHiddenColumns(commandOutputs...)...); err == nil && len(b) != 0 {
+			b = append(b, '\n')
+		}
 	case formatJSON:
 		if b, err = toJSON(commandOutputs...); err == nil && len(b) != 0 {
 			b = append(b, '\n')
 		}
 	case formatYAML:
 		b, err = toYAML(commandOutputs...)
+	case formatCSV:
+		b, err = toCSV(commandOutputs...)
+	case formatTSV:
+		b, err = toTSV(commandOutputs...)
+	case formatMarkdown:
+		b, err = toMarkdown(commandOutputs...)
 	default:
+		if isTemplateFormat(outputFormat) {
+			b, err = toTemplate(outputFormat, commandOutputs...)
+			break
+		}
 		err = fmt.Errorf("output format not valid: %s, valid formats: %v", outputFormat, formats())
 	}
 	if err != nil {
@@ -52,12 +87,15 @@ func Render(writer io.Writer, outputFormat string, commandOutputs ...Output) (er
 
 	// Render streaming outputs and count failed ones
 	failedCount := 0
+	var failure error
 	for _, commandOutput := range commandOutputs {
 		if rawOutput, ok := commandOutput.(Raw); ok {
 			_, cErr := io.Copy(writer, rawOutput)
 			err = errors.Join(err, cErr, rawOutput.Close())
 		} else if _, ok := commandOutput.(Error); ok {
 			failedCount++
+		} else if f, ok := commandOutput.(Failure); ok && failure == nil {
+			failure = f.Value
 		}
 	}
 
@@ -69,7 +107,7 @@ func Render(writer io.Writer, outputFormat string, commandOutputs ...Output) (er
 			FailedCount: failedCount,
 		}
 	}
-	return nil
+	return failure
 }
 
 func toHuman(commandOutputs ...Output) ([]byte, error) {
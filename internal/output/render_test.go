@@ -3,13 +3,17 @@ package output_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -94,3 +98,170 @@ func validateOutput(t *testing.T, expectedOutput string, expectedErrorMessage st
 		}
 	}
 }
+
+func TestRenderTemplate(t *testing.T) {
+	templateFile := filepath.Join(t.TempDir(), "template.tmpl")
+	require.NoError(t, os.WriteFile(templateFile, []byte(`{{range .}}{{.name}}={{.size}}{{"\n"}}{{end}}`), 0o600))
+
+	value := []map[string]any{
+		{"name": "first", "size": 10240},
+		{"name": "second", "size": 20},
+	}
+	for _, test := range []struct {
+		name     string
+		format   string
+		input    []output.Output
+		expected string
+		error    string
+	}{
+		{
+			name:     "jsonpath",
+			format:   "jsonpath={[*].name}",
+			input:    []output.Output{output.OnlyMarshaled{Value: value}},
+			expected: "first second",
+		},
+		{
+			name:     "jsonpath without braces",
+			format:   "jsonpath=[0].size",
+			input:    []output.Output{output.OnlyMarshaled{Value: value}},
+			expected: "10240",
+		},
+		{
+			name:     "go-template",
+			format:   `go-template={{range .}}{{.name}} {{end}}`,
+			input:    []output.Output{output.OnlyMarshaled{Value: value}},
+			expected: "first second ",
+		},
+		{
+			name:     "template-file",
+			format:   "template-file=" + templateFile,
+			input:    []output.Output{output.OnlyMarshaled{Value: value}},
+			expected: "first=10240\nsecond=20\n",
+		},
+		{
+			name:     "none",
+			format:   "jsonpath={.name}",
+			input:    []output.Output{output.None{}},
+			expected: "",
+		},
+		{
+			name:   "missing template",
+			format: "go-template=",
+			input:  []output.Output{output.None{}},
+			error:  "output format go-template requires a template, e.g., go-template=<template>",
+		},
+		{
+			name:     "failed command",
+			format:   "jsonpath={.error}",
+			input:    []output.Output{output.Error{Value: errors.New("MOCKERROR")}},
+			expected: "MOCKERROR",
+			error:    "Command execution failed for 1 resource(s)",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			err := output.Render(out, test.format, test.input...)
+			if test.error != "" {
+				assert.ErrorContains(t, err, test.error)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, out.String())
+		})
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	for format, expected := range map[string]string{
+		"human":                 "",
+		"json":                  "",
+		"markdown":              "",
+		"jsonpath={.uuid}":      "",
+		"go-template={{.uuid}}": "",
+		"toml":                  "output format 'toml' not accepted",
+		"json=true":             "output format 'json=true' not accepted",
+		"jsonpath={.uuid":       "invalid jsonpath expression: unclosed action",
+		"go-template={{.uuid":   "invalid template: template: go-template:1: unclosed action",
+		"template-file=":        "output format template-file requires a template, e.g., template-file=<template>",
+	} {
+		t.Run(format, func(t *testing.T) {
+			err := output.ValidateFormat(format)
+			if expected == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestRenderTableFormats(t *testing.T) {
+	table := output.Table{
+		Columns: []output.TableColumn{
+			{Key: "name", Header: "Name"},
+			{Key: "size", Header: "Size (GiB)", Format: func(val any) (text.Colors, string, error) {
+				return nil, fmt.Sprintf("%v GiB", val), nil
+			}},
+			{Key: "labels", Header: "Labels"},
+			{Key: "hidden", Header: "Hidden", Hidden: true},
+		},
+		Rows: []output.TableRow{
+			{"first", 10, []string{"env=dev", "team=a"}, "x"},
+			{"second, with | pipe", 20, []string{}, "y"},
+		},
+	}
+
+	for _, test := range []struct {
+		name     string
+		format   string
+		input    output.Output
+		expected string
+		error    string
+	}{
+		{
+			name:     "csv",
+			format:   "csv",
+			input:    table,
+			expected: "name,size,labels\nfirst,10,\"env=dev, team=a\"\n\"second, with | pipe\",20,\n",
+		},
+		{
+			name:     "tsv",
+			format:   "tsv",
+			input:    output.MarshaledWithHumanOutput{Value: "ignored", Output: table},
+			expected: "name\tsize\tlabels\nfirst\t10\tenv=dev, team=a\nsecond, with | pipe\t20\t\n",
+		},
+		{
+			name:   "markdown",
+			format: "markdown",
+			input:  table,
+			expected: `| Name | Size (GiB) | Labels |
+| --- | --- | --- |
+| first | 10 | env=dev, team=a |
+| second, with \| pipe | 20 |  |
+`,
+		},
+		{
+			name:   "details",
+			format: "csv",
+			input:  output.Details{},
+			error:  "output format csv is only supported for commands that output a table, use json or yaml output instead",
+		},
+		{
+			name:   "combined",
+			format: "markdown",
+			input:  output.Combined{{Key: "table", Contents: table}},
+			error:  "output format markdown is only supported for commands that output a table, use json or yaml output instead",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			err := output.Render(out, test.format, test.input)
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, out.String())
+		})
+	}
+}
@@ -67,16 +67,10 @@ func (s Table) MarshalHuman() ([]byte, error) {
 	t.ResetFooters()
 	t.ResetRows()
 	t.SetStyle(defaultTableStyle)
-	/*
-		// TODO: reimplement this if/when necessary
-		if len(s.overrideColumnKeys) > 0 {
-			columnKeys = s.overrideColumnKeys
-		}
-	*/
 	var header table.Row
 	for _, column := range s.Columns {
 		pos, ok := columnKeyPos[column.Key]
-		if !ok {
+		if !ok || column.Hidden {
 			continue
 		}
 		if column.Header == "" {
@@ -129,7 +123,7 @@ func (s Table) MarshalHuman() ([]byte, error) {
 	for _, row := range s.Rows {
 		var arow table.Row
 		for _, column := range s.Columns {
-			if _, ok := columnKeyPos[column.Key]; !ok {
+			if _, ok := columnKeyPos[column.Key]; !ok || column.Hidden {
 				continue
 			}
 			val := row[columnKeyPos[column.Key]]
@@ -0,0 +1,334 @@
+package output
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
+)
+
+// TableOptions defines how tables in command output are modified before rendering.
+type TableOptions struct {
+	// Columns defines the keys of the columns to include and their order. If empty, the default columns of the table are used.
+	Columns []string
+	// SortBy defines the key of the column to sort the rows by. Prefix the key with `-` to sort in descending order.
+	SortBy string
+	// Filters define the values the columns of the included rows must match.
+	Filters []filter.Filter
+	// Selector defines the labels the resources of the included rows must match.
+	Selector filter.Selector
+	// FilterValue defines whether the marshaled values of the outputs are filtered to match the filtered rows. This is only needed for output formats that render the marshaled value, see RendersValue.
+	FilterValue bool
+}
+
+func (o TableOptions) isEmpty() bool {
+	return len(o.Columns) == 0 && o.SortBy == "" && !o.isFiltered()
+}
+
+func (o TableOptions) isFiltered() bool {
+	return len(o.Filters) > 0 || len(o.Selector) > 0
+}
+
+// LabelsCell is implemented by table cell values that contain the labels of the resource. Label selectors are matched against these values.
+type LabelsCell interface {
+	LabelMap() map[string]string
+}
+
+// ApplyTableOptions filters rows, selects columns, and sorts rows of the table outputs. When rows are filtered and FilterValue is set, the marshaled value of the output is filtered as well, so that machine-readable outputs include the same resources as the table. Errors are returned for outputs that do not contain a table, e.g., details, if any of the options are set.
+func ApplyTableOptions(opts TableOptions, commandOutputs ...Output) ([]Output, error) {
+	if opts.isEmpty() {
+		return commandOutputs, nil
+	}
+
+	modified := make([]Output, len(commandOutputs))
+	for i, commandOutput := range commandOutputs {
+		switch o := commandOutput.(type) {
+		case Table:
+			t, _, err := o.applyOptions(opts)
+			if err != nil {
+				return nil, err
+			}
+			modified[i] = t
+		case MarshaledWithHumanOutput:
+			t, ok := o.Output.(Table)
+			if !ok {
+				return nil, errTableOptionsNotSupported
+			}
+			t, keep, err := t.applyOptions(opts)
+			if err != nil {
+				return nil, err
+			}
+			if opts.isFiltered() && opts.FilterValue {
+				if o.Value, err = filterValue(o.Value, keep); err != nil {
+					return nil, err
+				}
+			}
+			o.Output = t
+			modified[i] = o
+		case None, Error, Failure:
+			modified[i] = o
+		default:
+			return nil, errTableOptionsNotSupported
+		}
+	}
+	return modified, nil
+}
+
+var (
+	errTableOptionsNotSupported = errors.New("--columns, --sort-by, --filter, and --selector are only supported for commands that output a table")
+	errSelectorNotSupported     = errors.New("--selector is not supported for this command as its output does not include labels")
+	errFilterValueNotSupported  = errors.New("--filter and --selector are not supported for the machine-readable output of this command")
+)
+
+// applyOptions returns the modified table and which of the original rows were included in it.
+func (s Table) applyOptions(opts TableOptions) (Table, []bool, error) {
+	var keep []bool
+	var err error
+	if opts.isFiltered() {
+		if s, keep, err = s.filterRows(opts.Filters, opts.Selector); err != nil {
+			return s, nil, err
+		}
+	}
+	if opts.SortBy != "" {
+		if s, err = s.sortBy(opts.SortBy); err != nil {
+			return s, nil, err
+		}
+	}
+	if len(opts.Columns) > 0 {
+		if s, err = s.selectColumns(opts.Columns); err != nil {
+			return s, nil, err
+		}
+	}
+	return s, keep, nil
+}
+
+// filterRows returns a copy of the table that contains only the rows that match all filters and the label selector.
+func (s Table) filterRows(filters []filter.Filter, selector filter.Selector) (Table, []bool, error) {
+	indexes := make([]int, len(filters))
+	for i, f := range filters {
+		idx, err := s.columnIndex(f.Key)
+		if err != nil {
+			return s, nil, err
+		}
+		indexes[i] = idx
+	}
+
+	// Rows without labels, e.g., resources that do not support labels, never match the selector. If none of the rows have labels, the output does not support selectors.
+	hasLabels := false
+	keep := make([]bool, len(s.Rows))
+	rows := []TableRow{}
+	for i, row := range s.Rows {
+		match := true
+		for j, f := range filters {
+			if !f.Matches(rawCellValue(row.cell(indexes[j]))) {
+				match = false
+				break
+			}
+		}
+		if len(selector) > 0 {
+			labels, ok := rowLabels(row)
+			hasLabels = hasLabels || ok
+			match = match && ok && selector.Matches(labels)
+		}
+		if match {
+			keep[i] = true
+			rows = append(rows, row)
+		}
+	}
+	if len(selector) > 0 && !hasLabels && len(s.Rows) > 0 {
+		return s, nil, errSelectorNotSupported
+	}
+
+	s.Rows = rows
+	return s, keep, nil
+}
+
+func rowLabels(row TableRow) (map[string]string, bool) {
+	for _, cell := range row {
+		if labels, ok := cell.(LabelsCell); ok {
+			return labels.LabelMap(), true
+		}
+	}
+	return nil, false
+}
+
+// filterValue filters the marshaled value of an output to include only the items of the kept rows. The value must be a slice, or a struct with a single slice field, e.g., upcloud.Servers, with one item per table row.
+func filterValue(value any, keep []bool) (any, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return value, nil
+		}
+		filtered, err := filterValue(v.Elem().Interface(), keep)
+		if err != nil {
+			return nil, err
+		}
+		ptr := reflect.New(v.Elem().Type())
+		ptr.Elem().Set(reflect.ValueOf(filtered))
+		return ptr.Interface(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Len() != len(keep) {
+			return nil, errFilterValueNotSupported
+		}
+		filtered := reflect.MakeSlice(v.Type(), 0, v.Len())
+		for i := range v.Len() {
+			if keep[i] {
+				filtered = reflect.Append(filtered, v.Index(i))
+			}
+		}
+		return filtered.Interface(), nil
+	case reflect.Struct:
+		if v.NumField() != 1 || !v.Type().Field(0).IsExported() || v.Field(0).Kind() != reflect.Slice {
+			return nil, errFilterValueNotSupported
+		}
+		filtered, err := filterValue(v.Field(0).Interface(), keep)
+		if err != nil {
+			return nil, err
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Field(0).Set(reflect.ValueOf(filtered))
+		return copied.Interface(), nil
+	}
+	return nil, errFilterValueNotSupported
+}
+
+func (s Table) columnIndex(key string) (int, error) {
+	for i, column := range s.Columns {
+		if column.Key == key {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("unknown column %q, available columns are: %s", key, strings.Join(s.columnKeys(), ", "))
+}
+
+// selectColumns returns a copy of the table that contains only the columns with the given keys in the given order. Selected columns are shown even if they are hidden by default.
+func (s Table) selectColumns(keys []string) (Table, error) {
+	indexes := make([]int, len(keys))
+	columns := make([]TableColumn, len(keys))
+	for i, key := range keys {
+		idx, err := s.columnIndex(key)
+		if err != nil {
+			return s, err
+		}
+		indexes[i] = idx
+		columns[i] = s.Columns[idx]
+		columns[i].Hidden = false
+	}
+
+	rows := make([]TableRow, len(s.Rows))
+	for i, row := range s.Rows {
+		rows[i] = make(TableRow, len(indexes))
+		for j, idx := range indexes {
+			if idx < len(row) {
+				rows[i][j] = row[idx]
+			}
+		}
+	}
+
+	s.Columns = columns
+	s.Rows = rows
+	return s, nil
+}
+
+// sortBy returns a copy of the table with rows sorted by the column with the given key. If the key is prefixed with `-`, the rows are sorted in descending order.
+func (s Table) sortBy(key string) (Table, error) {
+	descending := strings.HasPrefix(key, "-")
+	key = strings.TrimPrefix(key, "-")
+
+	idx, err := s.columnIndex(key)
+	if err != nil {
+		return s, err
+	}
+
+	rows := slices.Clone(s.Rows)
+	slices.SortStableFunc(rows, func(a, b TableRow) int {
+		c := compareCells(a.cell(idx), b.cell(idx))
+		if descending {
+			return -c
+		}
+		return c
+	})
+
+	s.Rows = rows
+	return s, nil
+}
+
+// cell returns the value of the cell with the given index, or nil if the row is shorter than that.
+func (r TableRow) cell(idx int) any {
+	if idx < len(r) {
+		return r[idx]
+	}
+	return nil
+}
+
+// compareCells compares table cell values: numbers and durations are compared numerically, timestamps chronologically, and other values by their raw string value.
+func compareCells(a, b any) int {
+	switch at := a.(type) {
+	case time.Time:
+		if bt, ok := b.(time.Time); ok {
+			return at.Compare(bt)
+		}
+	case time.Duration:
+		if bt, ok := b.(time.Duration); ok {
+			return cmp.Compare(at, bt)
+		}
+	}
+
+	as, bs := rawCellValue(a), rawCellValue(b)
+	af, aErr := strconv.ParseFloat(as, 64)
+	bf, bErr := strconv.ParseFloat(bs, 64)
+	if aErr == nil && bErr == nil {
+		return cmp.Compare(af, bf)
+	}
+
+	// Durations formatted as strings, e.g., 1m30s, are compared by their length instead of lexically.
+	ad, aErr := time.ParseDuration(as)
+	bd, bErr := time.ParseDuration(bs)
+	if aErr == nil && bErr == nil {
+		return cmp.Compare(ad, bd)
+	}
+
+	return strings.Compare(as, bs)
+}
+
+// showHiddenColumns returns the outputs with hidden table columns shown. This is used to render the wide output.
+func showHiddenColumns(commandOutputs ...Output) []Output {
+	modified := make([]Output, len(commandOutputs))
+	for i, commandOutput := range commandOutputs {
+		switch o := commandOutput.(type) {
+		case Table:
+			modified[i] = o.showHidden()
+		case MarshaledWithHumanOutput:
+			o.Output = showHiddenColumns(o.Output)[0]
+			modified[i] = o
+		case Combined:
+			sections := make(Combined, len(o))
+			for j, section := range o {
+				section.Contents = showHiddenColumns(section.Contents)[0]
+				sections[j] = section
+			}
+			modified[i] = sections
+		default:
+			modified[i] = o
+		}
+	}
+	return modified
+}
+
+func (s Table) showHidden() Table {
+	columns := slices.Clone(s.Columns)
+	for i := range columns {
+		columns[i].Hidden = false
+	}
+	s.Columns = columns
+	return s
+}
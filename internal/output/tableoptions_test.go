@@ -0,0 +1,260 @@
+package output_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var optionsTestTable = output.Table{
+	Columns: []output.TableColumn{
+		{Key: "name", Header: "Name"},
+		{Key: "size", Header: "Size"},
+		{Key: "zone", Header: "Zone", Hidden: true},
+	},
+	Rows: []output.TableRow{
+		{"b", 25, "fi-hel1"},
+		{"c", 100, "de-fra1"},
+		{"a", 50, "fi-hel2"},
+	},
+}
+
+func TestApplyTableOptions(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		opts     output.TableOptions
+		input    output.Output
+		expected output.Output
+		err      string
+	}{
+		{
+			name:     "no options",
+			input:    optionsTestTable,
+			expected: optionsTestTable,
+		},
+		{
+			name:  "select columns",
+			opts:  output.TableOptions{Columns: []string{"zone", "name"}},
+			input: optionsTestTable,
+			expected: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "zone", Header: "Zone"},
+					{Key: "name", Header: "Name"},
+				},
+				Rows: []output.TableRow{
+					{"fi-hel1", "b"},
+					{"de-fra1", "c"},
+					{"fi-hel2", "a"},
+				},
+			},
+		},
+		{
+			name:  "sort numerically",
+			opts:  output.TableOptions{SortBy: "size", Columns: []string{"name"}},
+			input: optionsTestTable,
+			expected: output.Table{
+				Columns: []output.TableColumn{{Key: "name", Header: "Name"}},
+				Rows:    []output.TableRow{{"b"}, {"a"}, {"c"}},
+			},
+		},
+		{
+			name:  "sort descending",
+			opts:  output.TableOptions{SortBy: "-name", Columns: []string{"name"}},
+			input: output.MarshaledWithHumanOutput{Value: "value", Output: optionsTestTable},
+			expected: output.MarshaledWithHumanOutput{
+				Value: "value",
+				Output: output.Table{
+					Columns: []output.TableColumn{{Key: "name", Header: "Name"}},
+					Rows:    []output.TableRow{{"c"}, {"b"}, {"a"}},
+				},
+			},
+		},
+		{
+			name: "sort durations",
+			opts: output.TableOptions{SortBy: "time", Columns: []string{"name"}},
+			input: output.Table{
+				Columns: []output.TableColumn{{Key: "name", Header: "Name"}, {Key: "time", Header: "Time"}},
+				Rows: []output.TableRow{
+					{"a", 10 * time.Millisecond},
+					{"b", 9 * time.Millisecond},
+					{"c", time.Second},
+				},
+			},
+			expected: output.Table{
+				Columns: []output.TableColumn{{Key: "name", Header: "Name"}},
+				Rows:    []output.TableRow{{"b"}, {"a"}, {"c"}},
+			},
+		},
+		{
+			name: "sort formatted durations and short rows",
+			opts: output.TableOptions{SortBy: "formatted", Columns: []string{"name"}},
+			input: output.Table{
+				Columns: []output.TableColumn{{Key: "name", Header: "Name"}, {Key: "formatted", Header: "Formatted"}},
+				Rows: []output.TableRow{
+					{"a", "10ms"},
+					{"b", "9ms"},
+					{"c"},
+				},
+			},
+			expected: output.Table{
+				Columns: []output.TableColumn{{Key: "name", Header: "Name"}},
+				Rows:    []output.TableRow{{"c"}, {"b"}, {"a"}},
+			},
+		},
+		{
+			name:  "unknown column",
+			opts:  output.TableOptions{SortBy: "title"},
+			input: optionsTestTable,
+			err:   `unknown column "title", available columns are: name, size, zone`,
+		},
+		{
+			name:  "details output",
+			opts:  output.TableOptions{Columns: []string{"name"}},
+			input: output.Details{},
+			err:   "--columns, --sort-by, --filter, and --selector are only supported for commands that output a table",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			outputs, err := output.ApplyTableOptions(test.opts, test.input)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, []output.Output{test.expected}, outputs)
+		})
+	}
+}
+
+type testLabels map[string]string
+
+func (l testLabels) LabelMap() map[string]string {
+	return l
+}
+
+type testValue struct {
+	Items []string
+}
+
+func TestApplyTableOptions_Filter(t *testing.T) {
+	labeled := output.Table{
+		Columns: []output.TableColumn{
+			{Key: "name", Header: "Name"},
+			{Key: "zone", Header: "Zone"},
+			{Key: "labels", Header: "Labels", Hidden: true},
+		},
+		Rows: []output.TableRow{
+			{"a", "fi-hel1", testLabels{"env": "prod"}},
+			{"b", "de-fra1", testLabels{"env": "dev"}},
+			{"c", "fi-hel2", testLabels{}},
+		},
+	}
+	selector := func(in string) filter.Selector {
+		s, err := filter.ParseSelector(in)
+		require.NoError(t, err)
+		return s
+	}
+	filters := func(in ...string) []filter.Filter {
+		f, err := filter.ParseAll(in)
+		require.NoError(t, err)
+		return f
+	}
+
+	for _, test := range []struct {
+		name          string
+		opts          output.TableOptions
+		input         output.Output
+		expectedRows  []output.TableRow
+		expectedValue any
+		err           string
+	}{
+		{
+			name:         "filter",
+			opts:         output.TableOptions{Filters: filters("zone=fi-*"), Columns: []string{"name"}},
+			input:        labeled,
+			expectedRows: []output.TableRow{{"a"}, {"c"}},
+		},
+		{
+			name:         "selector",
+			opts:         output.TableOptions{Selector: selector("env in (prod,dev)"), Columns: []string{"name"}},
+			input:        labeled,
+			expectedRows: []output.TableRow{{"a"}, {"b"}},
+		},
+		{
+			name:          "filter and selector with value",
+			opts:          output.TableOptions{Filters: filters("zone!=de-fra1"), Selector: selector("!env"), Columns: []string{"name"}, FilterValue: true},
+			input:         output.MarshaledWithHumanOutput{Value: &testValue{Items: []string{"a", "b", "c"}}, Output: labeled},
+			expectedRows:  []output.TableRow{{"c"}},
+			expectedValue: &testValue{Items: []string{"c"}},
+		},
+		{
+			name: "negative selector excludes rows without labels",
+			opts: output.TableOptions{Selector: selector("!env"), Columns: []string{"name"}},
+			input: output.Table{
+				Columns: labeled.Columns,
+				Rows: []output.TableRow{
+					{"c", "fi-hel2", testLabels{}},
+					{"d", "fi-hel2", ""},
+				},
+			},
+			expectedRows: []output.TableRow{{"c"}},
+		},
+		{
+			name:  "selector without labels",
+			opts:  output.TableOptions{Selector: selector("env=prod")},
+			input: optionsTestTable,
+			err:   "--selector is not supported for this command as its output does not include labels",
+		},
+		{
+			name:          "value is not filtered for human output",
+			opts:          output.TableOptions{Filters: filters("zone=fi-hel1"), Columns: []string{"name"}},
+			input:         output.MarshaledWithHumanOutput{Value: map[string]string{"a": "b"}, Output: labeled},
+			expectedRows:  []output.TableRow{{"a"}},
+			expectedValue: map[string]string{"a": "b"},
+		},
+		{
+			name:  "unsupported value",
+			opts:  output.TableOptions{Filters: filters("zone=fi-hel1"), FilterValue: true},
+			input: output.MarshaledWithHumanOutput{Value: map[string]string{}, Output: labeled},
+			err:   "--filter and --selector are not supported for the machine-readable output of this command",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			outputs, err := output.ApplyTableOptions(test.opts, test.input)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			switch o := outputs[0].(type) {
+			case output.Table:
+				assert.Equal(t, test.expectedRows, o.Rows)
+			case output.MarshaledWithHumanOutput:
+				assert.Equal(t, test.expectedRows, o.Output.(output.Table).Rows)
+				assert.Equal(t, test.expectedValue, o.Value)
+			}
+		})
+	}
+}
+
+func TestRenderWide(t *testing.T) {
+	text.DisableColors()
+
+	human := new(bytes.Buffer)
+	require.NoError(t, output.Render(human, "human", optionsTestTable))
+	assert.NotContains(t, human.String(), "fi-hel1")
+
+	wide := new(bytes.Buffer)
+	require.NoError(t, output.Render(wide, "wide", optionsTestTable))
+	assert.Contains(t, wide.String(), "Zone")
+	assert.Contains(t, wide.String(), "fi-hel1")
+}
@@ -0,0 +1,134 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// templateRenderer renders the marshaled output with a JSONPath expression or a Go template. Both *jsonpath.JSONPath and *template.Template implement this interface.
+type templateRenderer interface {
+	Execute(wr io.Writer, data any) error
+}
+
+// ValidateFormat checks that the output format is valid. For template formats, the template is also parsed to catch syntax errors before executing the command.
+func ValidateFormat(outputFormat string) error {
+	name, _, _ := strings.Cut(outputFormat, "=")
+	switch name {
+	case formatHuman, formatWide, formatJSON, formatYAML, formatCSV, formatTSV, formatMarkdown:
+		if name != outputFormat {
+			return fmt.Errorf("output format '%v' not accepted", outputFormat)
+		}
+		return nil
+	case formatJSONPath, formatGoTemplate, formatTemplateFile:
+		_, err := parseTemplateFormat(outputFormat)
+		return err
+	default:
+		return fmt.Errorf("output format '%v' not accepted", outputFormat)
+	}
+}
+
+func isTemplateFormat(outputFormat string) bool {
+	name, _, found := strings.Cut(outputFormat, "=")
+	return found && (name == formatJSONPath || name == formatGoTemplate || name == formatTemplateFile)
+}
+
+// parseTemplateFormat parses the template from output format in `<format>=<template>` syntax.
+func parseTemplateFormat(outputFormat string) (templateRenderer, error) {
+	name, value, _ := strings.Cut(outputFormat, "=")
+	if value == "" {
+		return nil, fmt.Errorf("output format %s requires a template, e.g., %s=<template>", name, name)
+	}
+
+	switch name {
+	case formatJSONPath:
+		// Allow omitting the braces around simple expressions, e.g., `.uuid` instead of `{.uuid}`.
+		if !strings.Contains(value, "{") {
+			value = fmt.Sprintf("{%s}", value)
+		}
+
+		jp := jsonpath.New(name).AllowMissingKeys(true)
+		if err := jp.Parse(value); err != nil {
+			return nil, fmt.Errorf("invalid jsonpath expression: %w", err)
+		}
+		return jp, nil
+	case formatTemplateFile:
+		b, err := os.ReadFile(value)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read template file: %w", err)
+		}
+		value = string(b)
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// toTemplate renders the outputs with the template defined in the output format. The template is evaluated against the same value that is printed with JSON output.
+func toTemplate(outputFormat string, commandOutputs ...Output) ([]byte, error) {
+	renderer, err := parseTemplateFormat(outputFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonOutput, err := toJSON(commandOutputs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(jsonOutput) == 0 {
+		return []byte{}, nil
+	}
+
+	data, err := unmarshalJSONValue(jsonOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := renderer.Execute(buffer, data); err != nil {
+		return nil, fmt.Errorf("cannot render output: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// unmarshalJSONValue unmarshals JSON so that integers are not converted to floats. This avoids numbers such as storage sizes from being printed in exponent format.
+func unmarshalJSONValue(b []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return convertNumbers(value), nil
+}
+
+func convertNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = convertNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = convertNumbers(item)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+	return value
+}
@@ -34,6 +34,7 @@ func (s *CachingDatabase) Get(ctx context.Context, svc internal.AllServices) (Re
 		for _, db := range databases {
 			rv.AddMatch(db.UUID, MatchTitle(arg, db.Title))
 			rv.AddMatch(db.UUID, MatchUUID(arg, db.UUID))
+			rv.AddMatch(db.UUID, MatchLabels(arg, db.Labels))
 		}
 		return rv
 	}, nil
@@ -23,6 +23,7 @@ func (s CachingGateway) Get(ctx context.Context, svc internal.AllServices) (Reso
 		for _, gtw := range gateways {
 			rv.AddMatch(gtw.UUID, MatchTitle(arg, gtw.Name))
 			rv.AddMatch(gtw.UUID, MatchUUID(arg, gtw.UUID))
+			rv.AddMatch(gtw.UUID, MatchLabels(arg, gtw.Labels))
 		}
 		return rv
 	}, nil
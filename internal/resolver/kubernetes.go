@@ -36,6 +36,7 @@ func (s *CachingKubernetes) Get(ctx context.Context, svc service.AllServices) (R
 		for _, cluster := range clusters {
 			rv.AddMatch(cluster.UUID, MatchTitle(arg, cluster.Name))
 			rv.AddMatch(cluster.UUID, MatchUUID(arg, cluster.UUID))
+			rv.AddMatch(cluster.UUID, MatchLabels(arg, cluster.Labels))
 		}
 		return rv
 	}, nil
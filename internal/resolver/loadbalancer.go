@@ -35,6 +35,7 @@ func (s *CachingLoadBalancer) Get(ctx context.Context, svc internal.AllServices)
 		for _, lb := range loadbalancers {
 			rv.AddMatch(lb.UUID, MatchTitle(arg, lb.Name))
 			rv.AddMatch(lb.UUID, MatchUUID(arg, lb.UUID))
+			rv.AddMatch(lb.UUID, MatchLabels(arg, lb.Labels))
 		}
 		return rv
 	}, nil
@@ -44,3 +45,45 @@ func (s *CachingLoadBalancer) Get(ctx context.Context, svc internal.AllServices)
 func (s CachingLoadBalancer) PositionalArgumentHelp() string {
 	return helpUUIDName
 }
+
+// CachingCertificateBundle implements resolver for load balancer certificate bundles, caching the results
+type CachingCertificateBundle struct {
+	Cache[upcloud.LoadBalancerCertificateBundle]
+}
+
+// make sure we implement the ResolutionProvider interface
+var (
+	_ ResolutionProvider                                               = &CachingCertificateBundle{}
+	_ CachingResolutionProvider[upcloud.LoadBalancerCertificateBundle] = &CachingCertificateBundle{}
+)
+
+// Get implements ResolutionProvider.Get
+func (s *CachingCertificateBundle) Get(ctx context.Context, svc internal.AllServices) (Resolver, error) {
+	certBundles, err := svc.GetLoadBalancerCertificateBundles(ctx, &request.GetLoadBalancerCertificateBundlesRequest{
+		Page: &request.Page{
+			Number: 0,
+			Size:   100,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cb := range certBundles {
+		s.AddCached(cb.UUID, cb)
+	}
+
+	return func(arg string) Resolved {
+		rv := Resolved{Arg: arg}
+		for _, cb := range certBundles {
+			rv.AddMatch(cb.UUID, MatchTitle(arg, cb.Name))
+			rv.AddMatch(cb.UUID, MatchUUID(arg, cb.UUID))
+		}
+		return rv
+	}, nil
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s CachingCertificateBundle) PositionalArgumentHelp() string {
+	return helpUUIDName
+}
@@ -1,10 +1,17 @@
 package resolver
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/filter"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 )
 
+// LabelSelectorPrefix is the prefix of arguments that select resources by their labels, e.g., `label:env=prod`.
+const LabelSelectorPrefix = "label:"
+
 // MatchArgWithEqualFold checks if arg is a exact or case-insensitive match.
 func MatchArgWithEqualFold(arg, value string) MatchType {
 	if value == arg {
@@ -43,3 +50,40 @@ func MatchUUID(arg, value string) MatchType {
 	}
 	return MatchTypeNone
 }
+
+// IsLabelSelector checks if arg selects resources by their labels, i.e., if it has LabelSelectorPrefix.
+func IsLabelSelector(arg string) bool {
+	return strings.HasPrefix(arg, LabelSelectorPrefix)
+}
+
+func parseLabelSelector(arg string) (filter.Selector, error) {
+	selector, err := filter.ParseSelector(strings.TrimPrefix(arg, LabelSelectorPrefix))
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("label selector %q does not define any requirements", arg)
+	}
+	return selector, nil
+}
+
+// MatchLabels checks if arg is a label selector, e.g., `label:env=prod`, that matches the given labels.
+func MatchLabels(arg string, labels []upcloud.Label) MatchType {
+	if !IsLabelSelector(arg) {
+		return MatchTypeNone
+	}
+
+	selector, err := parseLabelSelector(arg)
+	if err != nil {
+		return MatchTypeNone
+	}
+
+	labelMap := make(map[string]string, len(labels))
+	for _, label := range labels {
+		labelMap[label.Key] = label.Value
+	}
+	if selector.Matches(labelMap) {
+		return MatchTypeLabelSelector
+	}
+	return MatchTypeNone
+}
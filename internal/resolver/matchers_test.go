@@ -3,6 +3,7 @@ package resolver
 import (
 	"testing"
 
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,3 +58,22 @@ func TestMatchers(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchLabels(t *testing.T) {
+	labels := []upcloud.Label{{Key: "env", Value: "prod"}, {Key: "role", Value: "worker"}}
+	for _, tt := range []struct {
+		arg      string
+		expected MatchType
+	}{
+		{arg: "label:env=prod", expected: MatchTypeLabelSelector},
+		{arg: "label:env=prod,role in (worker,db)", expected: MatchTypeLabelSelector},
+		{arg: "label:!owner", expected: MatchTypeLabelSelector},
+		{arg: "label:env=dev", expected: MatchTypeNone},
+		{arg: "label:env in (prod", expected: MatchTypeNone},
+		{arg: "env=prod", expected: MatchTypeNone},
+	} {
+		t.Run(tt.arg, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchLabels(tt.arg, labels))
+		})
+	}
+}
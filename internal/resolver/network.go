@@ -34,6 +34,7 @@ func (s *CachingNetwork) Get(ctx context.Context, svc internal.AllServices) (Res
 		for _, network := range networks.Networks {
 			rv.AddMatch(network.UUID, MatchTitle(arg, network.Name))
 			rv.AddMatch(network.UUID, MatchUUID(arg, network.UUID))
+			rv.AddMatch(network.UUID, MatchLabels(arg, network.Labels))
 		}
 		return rv
 	}, nil
@@ -35,6 +35,7 @@ func (s *CachingNetworkPeering) Get(ctx context.Context, svc internal.AllService
 
 			rv.AddMatch(peering.UUID, MatchTitle(arg, peering.Name))
 			rv.AddMatch(peering.UUID, MatchUUID(arg, peering.UUID))
+			rv.AddMatch(peering.UUID, MatchLabels(arg, peering.Labels))
 		}
 		return rv
 	}, nil
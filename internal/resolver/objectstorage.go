@@ -34,6 +34,7 @@ func (s *CachingObjectStorage) Get(ctx context.Context, svc internal.AllServices
 		for _, objsto := range objectstorages {
 			rv.AddMatch(objsto.UUID, MatchTitle(arg, objsto.Name))
 			rv.AddMatch(objsto.UUID, MatchUUID(arg, objsto.UUID))
+			rv.AddMatch(objsto.UUID, MatchLabels(arg, objsto.Labels))
 		}
 		return rv
 	}, nil
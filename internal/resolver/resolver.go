@@ -28,6 +28,8 @@ type CachingResolutionProvider[T any] interface {
 type MatchType int
 
 const (
+	// MatchTypeLabelSelector is used for arguments that select resources by their labels. These arguments are not expected to match names or UUIDs, so it is the highest match type.
+	MatchTypeLabelSelector   MatchType = 5
 	MatchTypeExact           MatchType = 4
 	MatchTypeCaseInsensitive MatchType = 3
 	MatchTypeGlobPattern     MatchType = 2
@@ -38,6 +40,7 @@ const (
 type Resolved struct {
 	Arg     string
 	matches map[string]MatchType
+	err     error
 }
 
 // AddMatch adds a match to the resolved value. If the match is already present, the highest match type is kept. I.e., exact match is kept over case insensitive match.
@@ -53,6 +56,7 @@ func (r *Resolved) AddMatch(uuid string, matchType MatchType) {
 func (r *Resolved) getAll() ([]string, MatchType) {
 	var all []string
 	for _, matchType := range []MatchType{
+		MatchTypeLabelSelector,
 		MatchTypeExact,
 		MatchTypeCaseInsensitive,
 		MatchTypeGlobPattern,
@@ -74,8 +78,12 @@ func (r *Resolved) getAll() ([]string, MatchType) {
 
 // GetAll returns matches with match-type that equals the highest available match-type for the resolved value. I.e., if there is an exact match, only exact matches are returned even if there would be case-insensitive matches.
 //
-// If match-type is not a glob pattern match, an error is returned if there are multiple matches.
+// If match-type is not a glob pattern or a label selector match, an error is returned if there are multiple matches.
 func (r *Resolved) GetAll() ([]string, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
 	all, matchType := r.getAll()
 
 	if len(all) == 0 {
@@ -83,7 +91,7 @@ func (r *Resolved) GetAll() ([]string, error) {
 	}
 
 	// For backwards compatibility, allow multiple matches only for glob patterns.
-	if len(all) > 1 && matchType != MatchTypeGlobPattern {
+	if len(all) > 1 && matchType != MatchTypeGlobPattern && matchType != MatchTypeLabelSelector {
 		return nil, NonGlobMultipleMatchesError(r.Arg)
 	}
 	return all, nil
@@ -91,6 +99,10 @@ func (r *Resolved) GetAll() ([]string, error) {
 
 // GetOnly returns the only match if there is only one match. If there are no or multiple matches, an empty value and an error is returned.
 func (r *Resolved) GetOnly() (string, error) {
+	if err := r.validate(); err != nil {
+		return "", err
+	}
+
 	all, _ := r.getAll()
 	if len(all) == 0 {
 		return "", NotFoundError(r.Arg)
@@ -102,3 +114,16 @@ func (r *Resolved) GetOnly() (string, error) {
 
 	return all[0], nil
 }
+
+// validate returns an error if the resolver failed or the argument is an invalid label selector.
+func (r *Resolved) validate() error {
+	if r.err != nil {
+		return r.err
+	}
+	if IsLabelSelector(r.Arg) {
+		if _, err := parseLabelSelector(r.Arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
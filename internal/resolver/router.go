@@ -39,6 +39,7 @@ func (s *CachingRouter) Get(ctx context.Context, svc internal.AllServices) (Reso
 		for _, router := range s.cache {
 			rv.AddMatch(router.UUID, MatchTitle(arg, router.Name))
 			rv.AddMatch(router.UUID, MatchUUID(arg, router.UUID))
+			rv.AddMatch(router.UUID, MatchLabels(arg, router.Labels))
 		}
 		return rv
 	}, nil
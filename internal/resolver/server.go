@@ -3,6 +3,7 @@ package resolver
 import (
 	"context"
 
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 )
@@ -10,6 +11,7 @@ import (
 // CachingServer implements resolver for servers, caching the results
 type CachingServer struct {
 	Cache[upcloud.Server]
+	labels map[string][]upcloud.Label
 }
 
 // make sure we implement the ResolutionProvider interfaces
@@ -31,14 +33,32 @@ func (s *CachingServer) Get(ctx context.Context, svc internal.AllServices) (Reso
 
 	return func(arg string) Resolved {
 		rv := Resolved{Arg: arg}
+		if IsLabelSelector(arg) {
+			rv.err = s.getLabels(ctx, svc, servers.Servers)
+		}
 		for _, server := range servers.Servers {
 			rv.AddMatch(server.UUID, MatchTitle(arg, server.Title, server.Hostname))
 			rv.AddMatch(server.UUID, MatchUUID(arg, server.UUID))
+			rv.AddMatch(server.UUID, MatchLabels(arg, s.labels[server.UUID]))
 		}
 		return rv
 	}, nil
 }
 
+// getLabels fetches the labels of the servers. Server list does not include labels, so they are fetched from server details only when a label selector is used.
+func (s *CachingServer) getLabels(ctx context.Context, svc internal.AllServices, servers []upcloud.Server) error {
+	if s.labels != nil {
+		return nil
+	}
+
+	serverLabels, err := labels.ServerLabels(ctx, svc, servers)
+	if err != nil {
+		return err
+	}
+	s.labels = serverLabels
+	return nil
+}
+
 // PositionalArgumentHelp implements resolver.ResolutionProvider
 func (s CachingServer) PositionalArgumentHelp() string {
 	return "<UUID/Title/Hostname...>"
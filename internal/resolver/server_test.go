@@ -9,6 +9,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
 
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -161,6 +162,37 @@ func TestServerResolution(t *testing.T) {
 		mService.AssertNumberOfCalls(t, "GetServers", 1)
 	})
 
+	t.Run("resolve label selector", func(t *testing.T) {
+		mService := &smock.Service{}
+		mService.On("GetServers").Return(allServers, nil)
+		for _, srv := range allServers.Servers {
+			details := &upcloud.ServerDetails{Server: srv}
+			if srv.Zone == "uk-lon1" {
+				details.Labels = upcloud.LabelSlice{{Key: "env", Value: "prod"}}
+			}
+			mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: srv.UUID}).Return(details, nil)
+		}
+		res := resolver.CachingServer{}
+		argResolver, err := res.Get(context.TODO(), mService)
+		assert.NoError(t, err)
+
+		resolved := argResolver("label:env=prod")
+		value, err := resolved.GetAll()
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{Server4.UUID, Server5.UUID}, value)
+
+		resolved = argResolver("label:env in (dev)")
+		_, err = resolved.GetAll()
+		assert.ErrorIs(t, err, resolver.NotFoundError("label:env in (dev)"))
+
+		resolved = argResolver("label:env in (prod")
+		_, err = resolved.GetAll()
+		assert.EqualError(t, err, `invalid selector "env in (prod", missing )`)
+
+		// make sure labels are fetched only once
+		mService.AssertNumberOfCalls(t, "GetServerDetails", len(allServers.Servers))
+	})
+
 	t.Run("failure situations", func(t *testing.T) {
 		mService := &smock.Service{}
 		mService.On("GetServers").Return(allServers, nil)
@@ -36,6 +36,7 @@ func (s *CachingServerGroup) Get(ctx context.Context, svc internal.AllServices)
 		for _, serverGroup := range serverGroups {
 			rv.AddMatch(serverGroup.UUID, MatchTitle(arg, serverGroup.Title))
 			rv.AddMatch(serverGroup.UUID, MatchUUID(arg, serverGroup.UUID))
+			rv.AddMatch(serverGroup.UUID, MatchLabels(arg, serverGroup.Labels))
 		}
 		return rv
 	}, nil
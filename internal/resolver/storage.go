@@ -38,6 +38,7 @@ func (s *CachingStorage) Get(ctx context.Context, svc internal.AllServices) (Res
 		for _, storage := range storages.Storages {
 			rv.AddMatch(storage.UUID, MatchTitle(arg, storage.Title))
 			rv.AddMatch(storage.UUID, MatchUUID(arg, storage.UUID))
+			rv.AddMatch(storage.UUID, MatchLabels(arg, storage.Labels))
 		}
 		return rv
 	}, nil
@@ -0,0 +1,797 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// fakeUUIDFormat is used to generate UUIDs for resources created in dry-run mode
+const fakeUUIDFormat = "00000000-0000-0000-0000-%012d"
+
+// Call is an API call that would have been made if dry-run mode was not enabled
+type Call struct {
+	Method  string `json:"method"`
+	URL     string `json:"url,omitempty"`
+	Request any    `json:"request"`
+}
+
+// DryRun wraps AllServices so that mutating calls are only recorded instead of sent to the API. Read-only calls are passed through to the wrapped service, so that, e.g., resolving resource names still works.
+type DryRun struct {
+	AllServices
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ AllServices = (*DryRun)(nil)
+
+// NewDryRun returns a new DryRun that passes read-only calls to the given service
+func NewDryRun(svc AllServices) *DryRun {
+	return &DryRun{AllServices: svc}
+}
+
+// Calls returns the recorded calls in the order they were made
+func (d *DryRun) Calls() []Call {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]Call{}, d.calls...)
+}
+
+// record stores the call and returns its sequence number
+func (d *DryRun) record(method string, r any) int {
+	call := Call{Method: method, Request: r}
+	if u, ok := r.(interface{ RequestURL() string }); ok {
+		call.URL = u.RequestURL()
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls = append(d.calls, call)
+	return len(d.calls)
+}
+
+// dryRunCall records the call and returns a response built from the request, so that commands making multiple calls can continue.
+func dryRunCall[T any](d *DryRun, method string, r any) (T, error) {
+	n := d.record(method, r)
+	return fakeResponse[T](r, fmt.Sprintf(fakeUUIDFormat, n)), nil
+}
+
+// dryRunWait returns a response in the desired state without waiting, as the planned operations are never started.
+func dryRunWait[T any](r any) (T, error) {
+	return fakeResponse[T](r, ""), nil
+}
+
+// fakeResponse builds a response by copying the fields of the request that have the same name and a compatible type. If the response does not have an UUID, it is set to the given UUID.
+func fakeResponse[T any](r any, uuid string) T {
+	var res T
+	v := reflect.ValueOf(&res).Elem()
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return res
+	}
+
+	src := reflect.Indirect(reflect.ValueOf(r))
+	if src.Kind() == reflect.Struct {
+		for i := range src.NumField() {
+			field := src.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if field.Name == "DesiredState" {
+				setField(v, "State", src.Field(i))
+				setField(v, "OperationalState", src.Field(i))
+				continue
+			}
+			setField(v, field.Name, src.Field(i))
+		}
+	}
+
+	if f := fieldByName(v, "UUID"); uuid != "" && f.IsValid() && f.Kind() == reflect.String && f.String() == "" {
+		f.SetString(uuid)
+	}
+	return res
+}
+
+func fieldByName(v reflect.Value, name string) reflect.Value {
+	sf, ok := v.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}
+	}
+	f, err := v.FieldByIndexErr(sf.Index)
+	if err != nil || !f.CanSet() {
+		return reflect.Value{}
+	}
+	return f
+}
+
+func setField(dst reflect.Value, name string, value reflect.Value) {
+	f := fieldByName(dst, name)
+	if !f.IsValid() {
+		return
+	}
+	if value.Kind() == reflect.Pointer && f.Kind() != reflect.Pointer {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+
+	switch {
+	case value.Type().AssignableTo(f.Type()):
+		f.Set(value)
+	case value.Kind() == f.Kind() && value.Type().ConvertibleTo(f.Type()):
+		f.Set(value.Convert(f.Type()))
+	}
+}
+
+func (d *DryRun) AddServerToServerGroup(_ context.Context, r *request.AddServerToServerGroupRequest) error {
+	d.record("AddServerToServerGroup", r)
+	return nil
+}
+
+func (d *DryRun) AssignIPAddress(_ context.Context, r *request.AssignIPAddressRequest) (*upcloud.IPAddress, error) {
+	return dryRunCall[*upcloud.IPAddress](d, "AssignIPAddress", r)
+}
+
+func (d *DryRun) AssignIPAddressToNetworkInterface(_ context.Context, r *request.AssignIPAddressToNetworkInterfaceRequest) (*upcloud.IPAddress, error) {
+	return dryRunCall[*upcloud.IPAddress](d, "AssignIPAddressToNetworkInterface", r)
+}
+
+func (d *DryRun) AttachLoadBalancerIPAddress(_ context.Context, r *request.AttachLoadBalancerIPAddressRequest) (upcloud.LoadBalancerFloatingIPAddress, error) {
+	return dryRunCall[upcloud.LoadBalancerFloatingIPAddress](d, "AttachLoadBalancerIPAddress", r)
+}
+
+func (d *DryRun) AttachManagedObjectStorageUserPolicy(_ context.Context, r *request.AttachManagedObjectStorageUserPolicyRequest) error {
+	d.record("AttachManagedObjectStorageUserPolicy", r)
+	return nil
+}
+
+func (d *DryRun) AttachNetworkRouter(_ context.Context, r *request.AttachNetworkRouterRequest) error {
+	d.record("AttachNetworkRouter", r)
+	return nil
+}
+
+func (d *DryRun) AttachStorage(_ context.Context, r *request.AttachStorageRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "AttachStorage", r)
+}
+
+func (d *DryRun) CancelManagedDatabaseSession(_ context.Context, r *request.CancelManagedDatabaseSession) error {
+	d.record("CancelManagedDatabaseSession", r)
+	return nil
+}
+
+func (d *DryRun) CloneManagedDatabase(_ context.Context, r *request.CloneManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
+	return dryRunCall[*upcloud.ManagedDatabase](d, "CloneManagedDatabase", r)
+}
+
+func (d *DryRun) CloneStorage(_ context.Context, r *request.CloneStorageRequest) (*upcloud.StorageDetails, error) {
+	return dryRunCall[*upcloud.StorageDetails](d, "CloneStorage", r)
+}
+
+func (d *DryRun) CreateBackup(_ context.Context, r *request.CreateBackupRequest) (*upcloud.StorageDetails, error) {
+	return dryRunCall[*upcloud.StorageDetails](d, "CreateBackup", r)
+}
+
+func (d *DryRun) CreateFirewallRule(_ context.Context, r *request.CreateFirewallRuleRequest) (*upcloud.FirewallRule, error) {
+	return dryRunCall[*upcloud.FirewallRule](d, "CreateFirewallRule", r)
+}
+
+func (d *DryRun) CreateFirewallRules(_ context.Context, r *request.CreateFirewallRulesRequest) error {
+	d.record("CreateFirewallRules", r)
+	return nil
+}
+
+func (d *DryRun) CreateGateway(_ context.Context, r *request.CreateGatewayRequest) (*upcloud.Gateway, error) {
+	return dryRunCall[*upcloud.Gateway](d, "CreateGateway", r)
+}
+
+func (d *DryRun) CreateGatewayConnection(_ context.Context, r *request.CreateGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
+	return dryRunCall[*upcloud.GatewayConnection](d, "CreateGatewayConnection", r)
+}
+
+func (d *DryRun) CreateGatewayConnectionTunnel(_ context.Context, r *request.CreateGatewayConnectionTunnelRequest) (*upcloud.GatewayTunnel, error) {
+	return dryRunCall[*upcloud.GatewayTunnel](d, "CreateGatewayConnectionTunnel", r)
+}
+
+func (d *DryRun) CreateKubernetesCluster(_ context.Context, r *request.CreateKubernetesClusterRequest) (*upcloud.KubernetesCluster, error) {
+	return dryRunCall[*upcloud.KubernetesCluster](d, "CreateKubernetesCluster", r)
+}
+
+func (d *DryRun) CreateKubernetesNodeGroup(_ context.Context, r *request.CreateKubernetesNodeGroupRequest) (*upcloud.KubernetesNodeGroup, error) {
+	return dryRunCall[*upcloud.KubernetesNodeGroup](d, "CreateKubernetesNodeGroup", r)
+}
+
+func (d *DryRun) CreateLoadBalancer(_ context.Context, r *request.CreateLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
+	return dryRunCall[*upcloud.LoadBalancer](d, "CreateLoadBalancer", r)
+}
+
+func (d *DryRun) CreateLoadBalancerBackend(_ context.Context, r *request.CreateLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
+	return dryRunCall[*upcloud.LoadBalancerBackend](d, "CreateLoadBalancerBackend", r)
+}
+
+func (d *DryRun) CreateLoadBalancerBackendMember(_ context.Context, r *request.CreateLoadBalancerBackendMemberRequest) (*upcloud.LoadBalancerBackendMember, error) {
+	return dryRunCall[*upcloud.LoadBalancerBackendMember](d, "CreateLoadBalancerBackendMember", r)
+}
+
+func (d *DryRun) CreateLoadBalancerBackendTLSConfig(_ context.Context, r *request.CreateLoadBalancerBackendTLSConfigRequest) (*upcloud.LoadBalancerBackendTLSConfig, error) {
+	return dryRunCall[*upcloud.LoadBalancerBackendTLSConfig](d, "CreateLoadBalancerBackendTLSConfig", r)
+}
+
+func (d *DryRun) CreateLoadBalancerCertificateBundle(_ context.Context, r *request.CreateLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
+	return dryRunCall[*upcloud.LoadBalancerCertificateBundle](d, "CreateLoadBalancerCertificateBundle", r)
+}
+
+func (d *DryRun) CreateLoadBalancerFrontend(_ context.Context, r *request.CreateLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
+	return dryRunCall[*upcloud.LoadBalancerFrontend](d, "CreateLoadBalancerFrontend", r)
+}
+
+func (d *DryRun) CreateLoadBalancerFrontendRule(_ context.Context, r *request.CreateLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
+	return dryRunCall[*upcloud.LoadBalancerFrontendRule](d, "CreateLoadBalancerFrontendRule", r)
+}
+
+func (d *DryRun) CreateLoadBalancerFrontendTLSConfig(_ context.Context, r *request.CreateLoadBalancerFrontendTLSConfigRequest) (*upcloud.LoadBalancerFrontendTLSConfig, error) {
+	return dryRunCall[*upcloud.LoadBalancerFrontendTLSConfig](d, "CreateLoadBalancerFrontendTLSConfig", r)
+}
+
+func (d *DryRun) CreateLoadBalancerResolver(_ context.Context, r *request.CreateLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
+	return dryRunCall[*upcloud.LoadBalancerResolver](d, "CreateLoadBalancerResolver", r)
+}
+
+func (d *DryRun) CreateManagedDatabase(_ context.Context, r *request.CreateManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
+	return dryRunCall[*upcloud.ManagedDatabase](d, "CreateManagedDatabase", r)
+}
+
+func (d *DryRun) CreateManagedObjectStorage(_ context.Context, r *request.CreateManagedObjectStorageRequest) (*upcloud.ManagedObjectStorage, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorage](d, "CreateManagedObjectStorage", r)
+}
+
+func (d *DryRun) CreateManagedObjectStorageBucket(_ context.Context, r *request.CreateManagedObjectStorageBucketRequest) (upcloud.ManagedObjectStorageBucketMetrics, error) {
+	return dryRunCall[upcloud.ManagedObjectStorageBucketMetrics](d, "CreateManagedObjectStorageBucket", r)
+}
+
+func (d *DryRun) CreateManagedObjectStorageCustomDomain(_ context.Context, r *request.CreateManagedObjectStorageCustomDomainRequest) error {
+	d.record("CreateManagedObjectStorageCustomDomain", r)
+	return nil
+}
+
+func (d *DryRun) CreateManagedObjectStorageNetwork(_ context.Context, r *request.CreateManagedObjectStorageNetworkRequest) (*upcloud.ManagedObjectStorageNetwork, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorageNetwork](d, "CreateManagedObjectStorageNetwork", r)
+}
+
+func (d *DryRun) CreateManagedObjectStoragePolicy(_ context.Context, r *request.CreateManagedObjectStoragePolicyRequest) (*upcloud.ManagedObjectStoragePolicy, error) {
+	return dryRunCall[*upcloud.ManagedObjectStoragePolicy](d, "CreateManagedObjectStoragePolicy", r)
+}
+
+func (d *DryRun) CreateManagedObjectStoragePolicyVersion(_ context.Context, r *request.CreateManagedObjectStoragePolicyVersionRequest) (*upcloud.ManagedObjectStoragePolicyVersion, error) {
+	return dryRunCall[*upcloud.ManagedObjectStoragePolicyVersion](d, "CreateManagedObjectStoragePolicyVersion", r)
+}
+
+func (d *DryRun) CreateManagedObjectStorageUser(_ context.Context, r *request.CreateManagedObjectStorageUserRequest) (*upcloud.ManagedObjectStorageUser, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorageUser](d, "CreateManagedObjectStorageUser", r)
+}
+
+func (d *DryRun) CreateManagedObjectStorageUserAccessKey(_ context.Context, r *request.CreateManagedObjectStorageUserAccessKeyRequest) (*upcloud.ManagedObjectStorageUserAccessKey, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorageUserAccessKey](d, "CreateManagedObjectStorageUserAccessKey", r)
+}
+
+func (d *DryRun) CreateNetwork(_ context.Context, r *request.CreateNetworkRequest) (*upcloud.Network, error) {
+	return dryRunCall[*upcloud.Network](d, "CreateNetwork", r)
+}
+
+func (d *DryRun) CreateNetworkInterface(_ context.Context, r *request.CreateNetworkInterfaceRequest) (*upcloud.Interface, error) {
+	return dryRunCall[*upcloud.Interface](d, "CreateNetworkInterface", r)
+}
+
+func (d *DryRun) CreateNetworkPeering(_ context.Context, r *request.CreateNetworkPeeringRequest) (*upcloud.NetworkPeering, error) {
+	return dryRunCall[*upcloud.NetworkPeering](d, "CreateNetworkPeering", r)
+}
+
+func (d *DryRun) CreatePartnerAccount(_ context.Context, r *request.CreatePartnerAccountRequest) (*upcloud.PartnerAccount, error) {
+	return dryRunCall[*upcloud.PartnerAccount](d, "CreatePartnerAccount", r)
+}
+
+func (d *DryRun) CreateRouter(_ context.Context, r *request.CreateRouterRequest) (*upcloud.Router, error) {
+	return dryRunCall[*upcloud.Router](d, "CreateRouter", r)
+}
+
+func (d *DryRun) CreateServer(_ context.Context, r *request.CreateServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "CreateServer", r)
+}
+
+func (d *DryRun) CreateServerGroup(_ context.Context, r *request.CreateServerGroupRequest) (*upcloud.ServerGroup, error) {
+	return dryRunCall[*upcloud.ServerGroup](d, "CreateServerGroup", r)
+}
+
+func (d *DryRun) CreateStorage(_ context.Context, r *request.CreateStorageRequest) (*upcloud.StorageDetails, error) {
+	return dryRunCall[*upcloud.StorageDetails](d, "CreateStorage", r)
+}
+
+func (d *DryRun) CreateStorageImport(_ context.Context, r *request.CreateStorageImportRequest) (*upcloud.StorageImportDetails, error) {
+	return dryRunCall[*upcloud.StorageImportDetails](d, "CreateStorageImport", r)
+}
+
+func (d *DryRun) CreateSubaccount(_ context.Context, r *request.CreateSubaccountRequest) (*upcloud.AccountDetails, error) {
+	return dryRunCall[*upcloud.AccountDetails](d, "CreateSubaccount", r)
+}
+
+func (d *DryRun) CreateTag(_ context.Context, r *request.CreateTagRequest) (*upcloud.Tag, error) {
+	return dryRunCall[*upcloud.Tag](d, "CreateTag", r)
+}
+
+func (d *DryRun) CreateToken(_ context.Context, r *request.CreateTokenRequest) (*upcloud.Token, error) {
+	return dryRunCall[*upcloud.Token](d, "CreateToken", r)
+}
+
+func (d *DryRun) DeleteFirewallRule(_ context.Context, r *request.DeleteFirewallRuleRequest) error {
+	d.record("DeleteFirewallRule", r)
+	return nil
+}
+
+func (d *DryRun) DeleteGateway(_ context.Context, r *request.DeleteGatewayRequest) error {
+	d.record("DeleteGateway", r)
+	return nil
+}
+
+func (d *DryRun) DeleteGatewayConnection(_ context.Context, r *request.DeleteGatewayConnectionRequest) error {
+	d.record("DeleteGatewayConnection", r)
+	return nil
+}
+
+func (d *DryRun) DeleteGatewayConnectionTunnel(_ context.Context, r *request.DeleteGatewayConnectionTunnelRequest) error {
+	d.record("DeleteGatewayConnectionTunnel", r)
+	return nil
+}
+
+func (d *DryRun) DeleteIPAddressFromNetworkInterface(_ context.Context, r *request.DeleteIPAddressFromNetworkInterfaceRequest) error {
+	d.record("DeleteIPAddressFromNetworkInterface", r)
+	return nil
+}
+
+func (d *DryRun) DeleteKubernetesCluster(_ context.Context, r *request.DeleteKubernetesClusterRequest) error {
+	d.record("DeleteKubernetesCluster", r)
+	return nil
+}
+
+func (d *DryRun) DeleteKubernetesNodeGroup(_ context.Context, r *request.DeleteKubernetesNodeGroupRequest) error {
+	d.record("DeleteKubernetesNodeGroup", r)
+	return nil
+}
+
+func (d *DryRun) DeleteKubernetesNodeGroupNode(_ context.Context, r *request.DeleteKubernetesNodeGroupNodeRequest) error {
+	d.record("DeleteKubernetesNodeGroupNode", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancer(_ context.Context, r *request.DeleteLoadBalancerRequest) error {
+	d.record("DeleteLoadBalancer", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerBackend(_ context.Context, r *request.DeleteLoadBalancerBackendRequest) error {
+	d.record("DeleteLoadBalancerBackend", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerBackendMember(_ context.Context, r *request.DeleteLoadBalancerBackendMemberRequest) error {
+	d.record("DeleteLoadBalancerBackendMember", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerBackendTLSConfig(_ context.Context, r *request.DeleteLoadBalancerBackendTLSConfigRequest) error {
+	d.record("DeleteLoadBalancerBackendTLSConfig", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerCertificateBundle(_ context.Context, r *request.DeleteLoadBalancerCertificateBundleRequest) error {
+	d.record("DeleteLoadBalancerCertificateBundle", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerFrontend(_ context.Context, r *request.DeleteLoadBalancerFrontendRequest) error {
+	d.record("DeleteLoadBalancerFrontend", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerFrontendRule(_ context.Context, r *request.DeleteLoadBalancerFrontendRuleRequest) error {
+	d.record("DeleteLoadBalancerFrontendRule", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerFrontendTLSConfig(_ context.Context, r *request.DeleteLoadBalancerFrontendTLSConfigRequest) error {
+	d.record("DeleteLoadBalancerFrontendTLSConfig", r)
+	return nil
+}
+
+func (d *DryRun) DeleteLoadBalancerResolver(_ context.Context, r *request.DeleteLoadBalancerResolverRequest) error {
+	d.record("DeleteLoadBalancerResolver", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedDatabase(_ context.Context, r *request.DeleteManagedDatabaseRequest) error {
+	d.record("DeleteManagedDatabase", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedDatabaseIndex(_ context.Context, r *request.DeleteManagedDatabaseIndexRequest) error {
+	d.record("DeleteManagedDatabaseIndex", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStorage(_ context.Context, r *request.DeleteManagedObjectStorageRequest) error {
+	d.record("DeleteManagedObjectStorage", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStorageBucket(_ context.Context, r *request.DeleteManagedObjectStorageBucketRequest) error {
+	d.record("DeleteManagedObjectStorageBucket", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStorageCustomDomain(_ context.Context, r *request.DeleteManagedObjectStorageCustomDomainRequest) error {
+	d.record("DeleteManagedObjectStorageCustomDomain", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStorageNetwork(_ context.Context, r *request.DeleteManagedObjectStorageNetworkRequest) error {
+	d.record("DeleteManagedObjectStorageNetwork", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStoragePolicy(_ context.Context, r *request.DeleteManagedObjectStoragePolicyRequest) error {
+	d.record("DeleteManagedObjectStoragePolicy", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStoragePolicyVersion(_ context.Context, r *request.DeleteManagedObjectStoragePolicyVersionRequest) error {
+	d.record("DeleteManagedObjectStoragePolicyVersion", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStorageUser(_ context.Context, r *request.DeleteManagedObjectStorageUserRequest) error {
+	d.record("DeleteManagedObjectStorageUser", r)
+	return nil
+}
+
+func (d *DryRun) DeleteManagedObjectStorageUserAccessKey(_ context.Context, r *request.DeleteManagedObjectStorageUserAccessKeyRequest) error {
+	d.record("DeleteManagedObjectStorageUserAccessKey", r)
+	return nil
+}
+
+func (d *DryRun) DeleteNetwork(_ context.Context, r *request.DeleteNetworkRequest) error {
+	d.record("DeleteNetwork", r)
+	return nil
+}
+
+func (d *DryRun) DeleteNetworkInterface(_ context.Context, r *request.DeleteNetworkInterfaceRequest) error {
+	d.record("DeleteNetworkInterface", r)
+	return nil
+}
+
+func (d *DryRun) DeleteNetworkPeering(_ context.Context, r *request.DeleteNetworkPeeringRequest) error {
+	d.record("DeleteNetworkPeering", r)
+	return nil
+}
+
+func (d *DryRun) DeleteRouter(_ context.Context, r *request.DeleteRouterRequest) error {
+	d.record("DeleteRouter", r)
+	return nil
+}
+
+func (d *DryRun) DeleteServer(_ context.Context, r *request.DeleteServerRequest) error {
+	d.record("DeleteServer", r)
+	return nil
+}
+
+func (d *DryRun) DeleteServerAndStorages(_ context.Context, r *request.DeleteServerAndStoragesRequest) error {
+	d.record("DeleteServerAndStorages", r)
+	return nil
+}
+
+func (d *DryRun) DeleteServerGroup(_ context.Context, r *request.DeleteServerGroupRequest) error {
+	d.record("DeleteServerGroup", r)
+	return nil
+}
+
+func (d *DryRun) DeleteStorage(_ context.Context, r *request.DeleteStorageRequest) error {
+	d.record("DeleteStorage", r)
+	return nil
+}
+
+func (d *DryRun) DeleteSubaccount(_ context.Context, r *request.DeleteSubaccountRequest) error {
+	d.record("DeleteSubaccount", r)
+	return nil
+}
+
+func (d *DryRun) DeleteTag(_ context.Context, r *request.DeleteTagRequest) error {
+	d.record("DeleteTag", r)
+	return nil
+}
+
+func (d *DryRun) DeleteToken(_ context.Context, r *request.DeleteTokenRequest) error {
+	d.record("DeleteToken", r)
+	return nil
+}
+
+func (d *DryRun) DetachManagedObjectStorageUserPolicy(_ context.Context, r *request.DetachManagedObjectStorageUserPolicyRequest) error {
+	d.record("DetachManagedObjectStorageUserPolicy", r)
+	return nil
+}
+
+func (d *DryRun) DetachNetworkRouter(_ context.Context, r *request.DetachNetworkRouterRequest) error {
+	d.record("DetachNetworkRouter", r)
+	return nil
+}
+
+func (d *DryRun) DetachStorage(_ context.Context, r *request.DetachStorageRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "DetachStorage", r)
+}
+
+func (d *DryRun) EjectCDROM(_ context.Context, r *request.EjectCDROMRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "EjectCDROM", r)
+}
+
+func (d *DryRun) GrantPermission(_ context.Context, r *request.GrantPermissionRequest) (*upcloud.Permission, error) {
+	return dryRunCall[*upcloud.Permission](d, "GrantPermission", r)
+}
+
+func (d *DryRun) LoadCDROM(_ context.Context, r *request.LoadCDROMRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "LoadCDROM", r)
+}
+
+func (d *DryRun) ModifyGateway(_ context.Context, r *request.ModifyGatewayRequest) (*upcloud.Gateway, error) {
+	return dryRunCall[*upcloud.Gateway](d, "ModifyGateway", r)
+}
+
+func (d *DryRun) ModifyGatewayConnection(_ context.Context, r *request.ModifyGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
+	return dryRunCall[*upcloud.GatewayConnection](d, "ModifyGatewayConnection", r)
+}
+
+func (d *DryRun) ModifyHost(_ context.Context, r *request.ModifyHostRequest) (*upcloud.Host, error) {
+	return dryRunCall[*upcloud.Host](d, "ModifyHost", r)
+}
+
+func (d *DryRun) ModifyIPAddress(_ context.Context, r *request.ModifyIPAddressRequest) (*upcloud.IPAddress, error) {
+	return dryRunCall[*upcloud.IPAddress](d, "ModifyIPAddress", r)
+}
+
+func (d *DryRun) ModifyKubernetesCluster(_ context.Context, r *request.ModifyKubernetesClusterRequest) (*upcloud.KubernetesCluster, error) {
+	return dryRunCall[*upcloud.KubernetesCluster](d, "ModifyKubernetesCluster", r)
+}
+
+func (d *DryRun) ModifyKubernetesNodeGroup(_ context.Context, r *request.ModifyKubernetesNodeGroupRequest) (*upcloud.KubernetesNodeGroup, error) {
+	return dryRunCall[*upcloud.KubernetesNodeGroup](d, "ModifyKubernetesNodeGroup", r)
+}
+
+func (d *DryRun) ModifyLoadBalancer(_ context.Context, r *request.ModifyLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
+	return dryRunCall[*upcloud.LoadBalancer](d, "ModifyLoadBalancer", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerBackend(_ context.Context, r *request.ModifyLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
+	return dryRunCall[*upcloud.LoadBalancerBackend](d, "ModifyLoadBalancerBackend", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerBackendMember(_ context.Context, r *request.ModifyLoadBalancerBackendMemberRequest) (*upcloud.LoadBalancerBackendMember, error) {
+	return dryRunCall[*upcloud.LoadBalancerBackendMember](d, "ModifyLoadBalancerBackendMember", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerBackendTLSConfig(_ context.Context, r *request.ModifyLoadBalancerBackendTLSConfigRequest) (*upcloud.LoadBalancerBackendTLSConfig, error) {
+	return dryRunCall[*upcloud.LoadBalancerBackendTLSConfig](d, "ModifyLoadBalancerBackendTLSConfig", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerCertificateBundle(_ context.Context, r *request.ModifyLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
+	return dryRunCall[*upcloud.LoadBalancerCertificateBundle](d, "ModifyLoadBalancerCertificateBundle", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerFrontend(_ context.Context, r *request.ModifyLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
+	return dryRunCall[*upcloud.LoadBalancerFrontend](d, "ModifyLoadBalancerFrontend", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerFrontendRule(_ context.Context, r *request.ModifyLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
+	return dryRunCall[*upcloud.LoadBalancerFrontendRule](d, "ModifyLoadBalancerFrontendRule", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerFrontendTLSConfig(_ context.Context, r *request.ModifyLoadBalancerFrontendTLSConfigRequest) (*upcloud.LoadBalancerFrontendTLSConfig, error) {
+	return dryRunCall[*upcloud.LoadBalancerFrontendTLSConfig](d, "ModifyLoadBalancerFrontendTLSConfig", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerNetwork(_ context.Context, r *request.ModifyLoadBalancerNetworkRequest) (*upcloud.LoadBalancerNetwork, error) {
+	return dryRunCall[*upcloud.LoadBalancerNetwork](d, "ModifyLoadBalancerNetwork", r)
+}
+
+func (d *DryRun) ModifyLoadBalancerResolver(_ context.Context, r *request.ModifyLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
+	return dryRunCall[*upcloud.LoadBalancerResolver](d, "ModifyLoadBalancerResolver", r)
+}
+
+func (d *DryRun) ModifyManagedDatabase(_ context.Context, r *request.ModifyManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
+	return dryRunCall[*upcloud.ManagedDatabase](d, "ModifyManagedDatabase", r)
+}
+
+func (d *DryRun) ModifyManagedDatabaseAccessControl(_ context.Context, r *request.ModifyManagedDatabaseAccessControlRequest) (*upcloud.ManagedDatabaseAccessControl, error) {
+	return dryRunCall[*upcloud.ManagedDatabaseAccessControl](d, "ModifyManagedDatabaseAccessControl", r)
+}
+
+func (d *DryRun) ModifyManagedObjectStorage(_ context.Context, r *request.ModifyManagedObjectStorageRequest) (*upcloud.ManagedObjectStorage, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorage](d, "ModifyManagedObjectStorage", r)
+}
+
+func (d *DryRun) ModifyManagedObjectStorageCustomDomain(_ context.Context, r *request.ModifyManagedObjectStorageCustomDomainRequest) (*upcloud.ManagedObjectStorageCustomDomain, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorageCustomDomain](d, "ModifyManagedObjectStorageCustomDomain", r)
+}
+
+func (d *DryRun) ModifyManagedObjectStorageUserAccessKey(_ context.Context, r *request.ModifyManagedObjectStorageUserAccessKeyRequest) (*upcloud.ManagedObjectStorageUserAccessKey, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorageUserAccessKey](d, "ModifyManagedObjectStorageUserAccessKey", r)
+}
+
+func (d *DryRun) ModifyNetwork(_ context.Context, r *request.ModifyNetworkRequest) (*upcloud.Network, error) {
+	return dryRunCall[*upcloud.Network](d, "ModifyNetwork", r)
+}
+
+func (d *DryRun) ModifyNetworkInterface(_ context.Context, r *request.ModifyNetworkInterfaceRequest) (*upcloud.Interface, error) {
+	return dryRunCall[*upcloud.Interface](d, "ModifyNetworkInterface", r)
+}
+
+func (d *DryRun) ModifyNetworkPeering(_ context.Context, r *request.ModifyNetworkPeeringRequest) (*upcloud.NetworkPeering, error) {
+	return dryRunCall[*upcloud.NetworkPeering](d, "ModifyNetworkPeering", r)
+}
+
+func (d *DryRun) ModifyRouter(_ context.Context, r *request.ModifyRouterRequest) (*upcloud.Router, error) {
+	return dryRunCall[*upcloud.Router](d, "ModifyRouter", r)
+}
+
+func (d *DryRun) ModifyServer(_ context.Context, r *request.ModifyServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "ModifyServer", r)
+}
+
+func (d *DryRun) ModifyServerGroup(_ context.Context, r *request.ModifyServerGroupRequest) (*upcloud.ServerGroup, error) {
+	return dryRunCall[*upcloud.ServerGroup](d, "ModifyServerGroup", r)
+}
+
+func (d *DryRun) ModifyStorage(_ context.Context, r *request.ModifyStorageRequest) (*upcloud.StorageDetails, error) {
+	return dryRunCall[*upcloud.StorageDetails](d, "ModifyStorage", r)
+}
+
+func (d *DryRun) ModifySubaccount(_ context.Context, r *request.ModifySubaccountRequest) (*upcloud.AccountDetails, error) {
+	return dryRunCall[*upcloud.AccountDetails](d, "ModifySubaccount", r)
+}
+
+func (d *DryRun) ModifyTag(_ context.Context, r *request.ModifyTagRequest) (*upcloud.Tag, error) {
+	return dryRunCall[*upcloud.Tag](d, "ModifyTag", r)
+}
+
+func (d *DryRun) ReleaseIPAddress(_ context.Context, r *request.ReleaseIPAddressRequest) error {
+	d.record("ReleaseIPAddress", r)
+	return nil
+}
+
+func (d *DryRun) RelocateServer(_ context.Context, r *request.RelocateServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "RelocateServer", r)
+}
+
+func (d *DryRun) RemoveLoadBalancerIPAddress(_ context.Context, r *request.RemoveLoadBalancerIPAddressRequest) error {
+	d.record("RemoveLoadBalancerIPAddress", r)
+	return nil
+}
+
+func (d *DryRun) RemoveServerFromServerGroup(_ context.Context, r *request.RemoveServerFromServerGroupRequest) error {
+	d.record("RemoveServerFromServerGroup", r)
+	return nil
+}
+
+func (d *DryRun) ReplaceLoadBalancerFrontendRule(_ context.Context, r *request.ReplaceLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
+	return dryRunCall[*upcloud.LoadBalancerFrontendRule](d, "ReplaceLoadBalancerFrontendRule", r)
+}
+
+func (d *DryRun) ReplaceManagedObjectStorage(_ context.Context, r *request.ReplaceManagedObjectStorageRequest) (*upcloud.ManagedObjectStorage, error) {
+	return dryRunCall[*upcloud.ManagedObjectStorage](d, "ReplaceManagedObjectStorage", r)
+}
+
+func (d *DryRun) ResizeStorageFilesystem(_ context.Context, r *request.ResizeStorageFilesystemRequest) (*upcloud.ResizeStorageFilesystemBackup, error) {
+	return dryRunCall[*upcloud.ResizeStorageFilesystemBackup](d, "ResizeStorageFilesystem", r)
+}
+
+func (d *DryRun) RestartServer(_ context.Context, r *request.RestartServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "RestartServer", r)
+}
+
+func (d *DryRun) RestoreBackup(_ context.Context, r *request.RestoreBackupRequest) error {
+	d.record("RestoreBackup", r)
+	return nil
+}
+
+func (d *DryRun) RevokePermission(_ context.Context, r *request.RevokePermissionRequest) error {
+	d.record("RevokePermission", r)
+	return nil
+}
+
+func (d *DryRun) ShutdownManagedDatabase(_ context.Context, r *request.ShutdownManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
+	return dryRunCall[*upcloud.ManagedDatabase](d, "ShutdownManagedDatabase", r)
+}
+
+func (d *DryRun) StartManagedDatabase(_ context.Context, r *request.StartManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
+	return dryRunCall[*upcloud.ManagedDatabase](d, "StartManagedDatabase", r)
+}
+
+func (d *DryRun) StartServer(_ context.Context, r *request.StartServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "StartServer", r)
+}
+
+func (d *DryRun) StopServer(_ context.Context, r *request.StopServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "StopServer", r)
+}
+
+func (d *DryRun) TagServer(_ context.Context, r *request.TagServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "TagServer", r)
+}
+
+func (d *DryRun) TemplatizeStorage(_ context.Context, r *request.TemplatizeStorageRequest) (*upcloud.StorageDetails, error) {
+	return dryRunCall[*upcloud.StorageDetails](d, "TemplatizeStorage", r)
+}
+
+func (d *DryRun) UntagServer(_ context.Context, r *request.UntagServerRequest) (*upcloud.ServerDetails, error) {
+	return dryRunCall[*upcloud.ServerDetails](d, "UntagServer", r)
+}
+
+func (d *DryRun) UpgradeKubernetesCluster(_ context.Context, r *request.UpgradeKubernetesClusterRequest) (*upcloud.KubernetesClusterUpgrade, error) {
+	return dryRunCall[*upcloud.KubernetesClusterUpgrade](d, "UpgradeKubernetesCluster", r)
+}
+
+func (d *DryRun) UpgradeManagedDatabaseVersion(_ context.Context, r *request.UpgradeManagedDatabaseVersionRequest) (*upcloud.ManagedDatabase, error) {
+	return dryRunCall[*upcloud.ManagedDatabase](d, "UpgradeManagedDatabaseVersion", r)
+}
+
+// WaitFor* methods return immediately as the planned operations are never started.
+
+func (d *DryRun) WaitForKubernetesClusterState(_ context.Context, r *request.WaitForKubernetesClusterStateRequest) (*upcloud.KubernetesCluster, error) {
+	return dryRunWait[*upcloud.KubernetesCluster](r)
+}
+
+func (d *DryRun) WaitForKubernetesNodeGroupState(_ context.Context, r *request.WaitForKubernetesNodeGroupStateRequest) (*upcloud.KubernetesNodeGroup, error) {
+	return dryRunWait[*upcloud.KubernetesNodeGroup](r)
+}
+
+func (d *DryRun) WaitForLoadBalancerDeletion(_ context.Context, _ *request.WaitForLoadBalancerDeletionRequest) error {
+	return nil
+}
+
+func (d *DryRun) WaitForLoadBalancerOperationalState(_ context.Context, r *request.WaitForLoadBalancerOperationalStateRequest) (*upcloud.LoadBalancer, error) {
+	return dryRunWait[*upcloud.LoadBalancer](r)
+}
+
+func (d *DryRun) WaitForManagedDatabaseState(_ context.Context, r *request.WaitForManagedDatabaseStateRequest) (*upcloud.ManagedDatabase, error) {
+	return dryRunWait[*upcloud.ManagedDatabase](r)
+}
+
+func (d *DryRun) WaitForManagedObjectStorageBucketDeletion(_ context.Context, _ *request.WaitForManagedObjectStorageBucketDeletionRequest) error {
+	return nil
+}
+
+func (d *DryRun) WaitForManagedObjectStorageDeletion(_ context.Context, _ *request.WaitForManagedObjectStorageDeletionRequest) error {
+	return nil
+}
+
+func (d *DryRun) WaitForManagedObjectStorageOperationalState(_ context.Context, r *request.WaitForManagedObjectStorageOperationalStateRequest) (*upcloud.ManagedObjectStorage, error) {
+	return dryRunWait[*upcloud.ManagedObjectStorage](r)
+}
+
+func (d *DryRun) WaitForNetworkPeeringState(_ context.Context, r *request.WaitForNetworkPeeringStateRequest) (*upcloud.NetworkPeering, error) {
+	return dryRunWait[*upcloud.NetworkPeering](r)
+}
+
+func (d *DryRun) WaitForServerState(_ context.Context, r *request.WaitForServerStateRequest) (*upcloud.ServerDetails, error) {
+	return dryRunWait[*upcloud.ServerDetails](r)
+}
+
+func (d *DryRun) WaitForStorageImportCompletion(_ context.Context, r *request.WaitForStorageImportCompletionRequest) (*upcloud.StorageImportDetails, error) {
+	return dryRunWait[*upcloud.StorageImportDetails](r)
+}
+
+func (d *DryRun) WaitForStorageState(_ context.Context, r *request.WaitForStorageStateRequest) (*upcloud.StorageDetails, error) {
+	return dryRunWait[*upcloud.StorageDetails](r)
+}
@@ -0,0 +1,53 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+	mService := &smock.Service{}
+	mService.On("GetAccount").Return(&upcloud.Account{UserName: "test"}, nil)
+	dryRun := service.NewDryRun(mService)
+
+	// Read-only calls are passed through
+	account, err := dryRun.GetAccount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "test", account.UserName)
+
+	createReq := &request.CreateServerRequest{Hostname: "example.com", Title: "example", Zone: "fi-hel1"}
+	server, err := dryRun.CreateServer(ctx, createReq)
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", server.Hostname)
+	assert.Equal(t, "fi-hel1", server.Zone)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", server.UUID)
+
+	waitReq := &request.WaitForServerStateRequest{UUID: server.UUID, DesiredState: upcloud.ServerStateStarted}
+	server, err = dryRun.WaitForServerState(ctx, waitReq)
+	require.NoError(t, err)
+	assert.Equal(t, upcloud.ServerStateStarted, server.State)
+
+	lb, err := dryRun.ModifyLoadBalancer(ctx, &request.ModifyLoadBalancerRequest{UUID: "lb-uuid", Name: "lb", Plan: "production-small"})
+	require.NoError(t, err)
+	assert.Equal(t, "lb-uuid", lb.UUID)
+	assert.Equal(t, "lb", lb.Name)
+
+	deleteReq := &request.DeleteServerRequest{UUID: server.UUID}
+	require.NoError(t, dryRun.DeleteServer(ctx, deleteReq))
+
+	assert.Equal(t, []service.Call{
+		{Method: "CreateServer", URL: "/server", Request: createReq},
+		{Method: "ModifyLoadBalancer", URL: "/load-balancer/lb-uuid", Request: &request.ModifyLoadBalancerRequest{UUID: "lb-uuid", Name: "lb", Plan: "production-small"}},
+		{Method: "DeleteServer", URL: "/server/00000000-0000-0000-0000-000000000001", Request: deleteReq},
+	}, dryRun.Calls())
+	mService.AssertNotCalled(t, "CreateServer")
+}
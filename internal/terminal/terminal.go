@@ -8,15 +8,22 @@ import (
 )
 
 var (
+	isStdinTerminal  bool
 	isStdoutTerminal bool
 	isStderrTerminal bool
 )
 
 func init() {
+	isStdinTerminal = isatty.IsTerminal(os.Stdin.Fd())
 	isStdoutTerminal = isatty.IsTerminal(os.Stdout.Fd())
 	isStderrTerminal = isatty.IsTerminal(os.Stderr.Fd())
 }
 
+// IsStdinTerminal returns true if the terminal is stdin
+func IsStdinTerminal() bool {
+	return isStdinTerminal
+}
+
 // IsStdoutTerminal returns true if the terminal is stdout
 func IsStdoutTerminal() bool {
 	return isStdoutTerminal
@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"math"
+	"strings"
+)
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline returns the given values as a compact bar chart. If there are more values than width, consecutive values are averaged to fit the chart into width characters.
+func Sparkline(values []float64, width int) string {
+	if len(values) == 0 || width < 1 {
+		return ""
+	}
+
+	if len(values) > width {
+		buckets := make([]float64, width)
+		for i := range buckets {
+			start := i * len(values) / width
+			end := (i + 1) * len(values) / width
+			sum := 0.0
+			for _, v := range values[start:end] {
+				sum += v
+			}
+			buckets[i] = sum / float64(end-start)
+		}
+		values = buckets
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		idx := 0
+		if hi > lo {
+			idx = int((v - lo) / (hi - lo) * float64(len(sparklineTicks)-1))
+		}
+		sb.WriteRune(sparklineTicks[idx])
+	}
+	return sb.String()
+}
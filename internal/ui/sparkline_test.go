@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		values   []float64
+		width    int
+		expected string
+	}{
+		{
+			name:     "empty",
+			values:   nil,
+			width:    10,
+			expected: "",
+		},
+		{
+			name:     "constant",
+			values:   []float64{5, 5, 5},
+			width:    10,
+			expected: "▁▁▁",
+		},
+		{
+			name:     "increasing",
+			values:   []float64{0, 1, 2, 3, 4, 5, 6, 7},
+			width:    10,
+			expected: "▁▂▃▄▅▆▇█",
+		},
+		{
+			name:     "averaged to width",
+			values:   []float64{0, 0, 10, 10, 5, 5},
+			width:    3,
+			expected: "▁█▄",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Sparkline(test.values, test.width))
+		})
+	}
+}